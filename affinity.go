@@ -0,0 +1,41 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpcplatform
+
+import (
+	"context"
+
+	"github.com/nexcode/rpcplatform/internal/affinity"
+	"google.golang.org/grpc/metadata"
+)
+
+// AffinityToken returns the affinity token from the trailer of a call to a server with the Affinity option.
+// It returns an empty string if the trailer does not contain a token, for example, when the call already presented it.
+func AffinityToken(trailer metadata.MD) string {
+	if tokens := trailer.Get(affinity.MetadataKey); len(tokens) != 0 {
+		return tokens[len(tokens)-1]
+	}
+
+	return ""
+}
+
+// WithAffinityToken returns a copy of ctx that presents the affinity token with calls made with it.
+// A client with the Affinity option routes such calls to the server that issued the token while it stays ready;
+// otherwise, calls are balanced as usual and the trailer carries a new token.
+func WithAffinityToken(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, affinity.MetadataKey, token)
+}
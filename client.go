@@ -17,15 +17,31 @@
 package rpcplatform
 
 import (
+	"context"
+	"sync"
+
+	"github.com/nexcode/rpcplatform/internal/balancer/picker"
 	"github.com/nexcode/rpcplatform/internal/config"
+	"github.com/nexcode/rpcplatform/internal/limiter"
+	"github.com/nexcode/rpcplatform/internal/telemetry"
+	"github.com/nexcode/rpcplatform/internal/waiter"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/resolver/manual"
 )
 
 type Client struct {
-	id       string
-	target   string
-	client   *grpc.ClientConn
-	resolver *manual.Resolver
-	config   *config.Client
+	id        string
+	name      string
+	target    string
+	client    *grpc.ClientConn
+	resolver  *manual.Resolver
+	config    *config.Client
+	endpoints *picker.Endpoints
+	limiter   *limiter.Limiter
+	waiter    *waiter.Waiter
+	metrics   *telemetry.Metrics
+
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+	closeErr error
 }
@@ -0,0 +1,26 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpcplatform
+
+// Close stops watching the servers, closes the gRPC client and waits for the background goroutines to finish.
+// Calls in flight fail with the CANCELED status. Close is idempotent and safe to call concurrently.
+func (c *Client) Close() error {
+	c.cancel()
+	c.wg.Wait()
+
+	return c.closeErr
+}
@@ -17,20 +17,32 @@
 package rpcplatform
 
 import (
+	"github.com/nexcode/rpcplatform/internal/balancer"
 	"github.com/nexcode/rpcplatform/internal/grpcattrs"
 	"google.golang.org/grpc/resolver"
 )
 
-func (c *Client) updateState(init bool, serverInfoTree map[string]*ServerInfo) {
+func (c *Client) updateState(init bool, clientState clientState) {
+	config := c.config
+
+	if clientState.trafficSplit != nil {
+		configCopy := *config
+		configCopy.TrafficSplit = clientState.trafficSplit
+		config = &configCopy
+	}
+
 	state := resolver.State{
-		Endpoints:  make([]resolver.Endpoint, 0, len(serverInfoTree)),
-		Attributes: grpcattrs.SetClientConfig(nil, c.config),
+		Endpoints:  make([]resolver.Endpoint, 0, len(clientState.serverInfoTree)),
+		Attributes: balancer.SetEndpoints(grpcattrs.SetClientConfig(nil, config), c.endpoints),
 	}
 
-	for _, value := range serverInfoTree {
+	for key, value := range clientState.serverInfoTree {
 		state.Endpoints = append(state.Endpoints, resolver.Endpoint{
-			Addresses:  []resolver.Address{{Addr: value.Address}},
-			Attributes: grpcattrs.SetAttributes(nil, value.Attributes),
+			Addresses: []resolver.Address{{
+				Addr:               value.Address,
+				BalancerAttributes: grpcattrs.SetServerID(nil, key),
+			}},
+			Attributes: grpcattrs.SetServerID(grpcattrs.SetAttributes(nil, value.Attributes), key),
 		})
 	}
 
@@ -39,4 +51,10 @@ func (c *Client) updateState(init bool, serverInfoTree map[string]*ServerInfo) {
 	} else {
 		c.resolver.UpdateState(state)
 	}
+
+	c.metrics.Instances(c.name, len(clientState.serverInfoTree))
+
+	if c.waiter != nil {
+		c.waiter.Set(len(clientState.serverInfoTree) != 0)
+	}
 }
@@ -0,0 +1,54 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpcplatform
+
+import (
+	"github.com/nexcode/rpcplatform/internal/config"
+)
+
+// watchState updates the client with changes of the servers and the traffic split until either channel is closed.
+// Then it cancels the context of the client, so that the other etcd watch ends as well and the client is closed.
+func (c *Client) watchState(clientState clientState, serverInfoTrees <-chan map[string]*ServerInfo, trafficSplits <-chan *config.TrafficSplit) {
+	defer func() {
+		c.cancel()
+
+		for range serverInfoTrees {
+		}
+
+		for range trafficSplits {
+		}
+	}()
+
+	for {
+		select {
+		case serverInfoTree, ok := <-serverInfoTrees:
+			if !ok {
+				return
+			}
+
+			clientState.serverInfoTree = serverInfoTree
+		case trafficSplit, ok := <-trafficSplits:
+			if !ok {
+				return
+			}
+
+			clientState.trafficSplit = trafficSplit
+		}
+
+		c.updateState(false, clientState)
+	}
+}
@@ -0,0 +1,44 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpcplatform
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/nexcode/rpcplatform/internal/config"
+)
+
+// trafficSplitPrefix is appended to the etcd prefix to store traffic splits outside the server listings,
+// so that clients do not mistake them for servers.
+const trafficSplitPrefix = ".trafficSplit"
+
+type clientState struct {
+	serverInfoTree map[string]*ServerInfo
+	trafficSplit   *config.TrafficSplit
+}
+
+func makeTrafficSplit(value []byte) *config.TrafficSplit {
+	trafficSplit := &config.TrafficSplit{}
+
+	if err := json.Unmarshal(value, trafficSplit); err != nil {
+		log.Println(err)
+		return nil
+	}
+
+	return trafficSplit
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpcplatform
+
+import (
+	"github.com/nexcode/rpcplatform/internal/balancer/picker"
+)
+
+// ClientStats contains the balancing state of a client.
+type ClientStats struct {
+	// ActiveRequests is the number of calls and streams in flight.
+	// It is counted only when the MaxConcurrentRequests option is set.
+	ActiveRequests int
+
+	// QueuedRequests is the number of calls and streams waiting for the concurrency limit.
+	QueuedRequests int
+
+	// Endpoints contains the state of each known server keyed by server ID.
+	Endpoints map[string]*EndpointStats
+}
+
+// EndpointStats contains the balancing state of a single server.
+type EndpointStats = picker.EndpointStats
+
+// CircuitState is the state of the circuit breaker of a server.
+type CircuitState = picker.CircuitState
+
+const (
+	CircuitClosed   = picker.CircuitClosed
+	CircuitOpen     = picker.CircuitOpen
+	CircuitHalfOpen = picker.CircuitHalfOpen
+)
@@ -0,0 +1,38 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpcplatform
+
+import (
+	"context"
+
+	"github.com/nexcode/rpcplatform/internal/admission"
+	"google.golang.org/grpc/metadata"
+)
+
+// Criticality is the importance of a call used by servers with the ConcurrencyLimit option to decide which calls are shed first.
+type Criticality = admission.Criticality
+
+const (
+	CriticalitySheddable = admission.CriticalitySheddable
+	CriticalityDefault   = admission.CriticalityDefault
+	CriticalityCritical  = admission.CriticalityCritical
+)
+
+// WithCriticality returns a copy of ctx that sends the criticality with calls made with it.
+func WithCriticality(ctx context.Context, criticality Criticality) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, admission.MetadataKey, criticality.String())
+}
@@ -18,10 +18,18 @@ package rpcplatform
 
 import (
 	"errors"
+
+	"github.com/nexcode/rpcplatform/internal/balancer/picker"
 )
 
 var (
 	ErrInvalidEtcdPrefix = errors.New("invalid etcd prefix")
 	ErrInvalidTargetName = errors.New("invalid target name")
 	ErrInvalidServerName = errors.New("invalid server name")
+	ErrIdentityNeedsTLS  = errors.New("service identity requires TLS")
+
+	// ErrNoServers is returned by calls when the target has no registered servers.
+	// It is a gRPC status error with the UNAVAILABLE code; use errors.Is to match it.
+	// Calls with grpc.WaitForReady block until a server is registered instead.
+	ErrNoServers = picker.ErrNoServers
 )
@@ -0,0 +1,165 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admission
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nexcode/rpcplatform/internal/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestLimiter_Admit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		criticality Criticality
+		expected    int
+	}{
+		{CriticalitySheddable, 5},
+		{CriticalityDefault, 9},
+		{CriticalityCritical, 10},
+	}
+
+	for _, tt := range tests {
+		l := New(&config.ConcurrencyLimit{Limit: 10})
+
+		admitted := 0
+		for l.Admit(tt.criticality) {
+			admitted++
+		}
+
+		if admitted != tt.expected {
+			t.Errorf("%v calls admitted = %v, want: %v", tt.criticality, admitted, tt.expected)
+		}
+	}
+}
+
+func TestLimiter_Release(t *testing.T) {
+	t.Parallel()
+
+	l := New(&config.ConcurrencyLimit{
+		Limit:            10,
+		Adaptive:         true,
+		MinLimit:         5,
+		MaxLimit:         11,
+		LatencyThreshold: time.Second,
+		BackoffRatio:     0.5,
+	})
+
+	tests := []struct {
+		name     string
+		inflight int
+		duration time.Duration
+		code     codes.Code
+		expected int
+	}{
+		{"Fast call with low utilization", 1, time.Millisecond, codes.OK, 10},
+		{"Fast call with high utilization", 5, time.Millisecond, codes.OK, 11},
+		{"Limit is at the maximum", 5, time.Millisecond, codes.OK, 11},
+		{"Slow call", 1, 2 * time.Second, codes.OK, 5},
+		{"Limit is at the minimum", 1, time.Millisecond, codes.DeadlineExceeded, 5},
+	}
+
+	for _, tt := range tests {
+		l.inflight = tt.inflight
+		l.Release(tt.duration, tt.code)
+
+		if limit := l.Limit(); limit != tt.expected {
+			t.Errorf("%v: Limit() = %v, want: %v", tt.name, limit, tt.expected)
+		}
+	}
+}
+
+func TestFromIncomingContext(t *testing.T) {
+	t.Parallel()
+
+	for _, criticality := range []Criticality{CriticalitySheddable, CriticalityDefault, CriticalityCritical} {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, criticality.String()))
+
+		if c := FromIncomingContext(ctx); c != criticality {
+			t.Errorf("FromIncomingContext() = %v, want: %v", c, criticality)
+		}
+	}
+
+	if c := FromIncomingContext(context.Background()); c != CriticalityDefault {
+		t.Errorf("FromIncomingContext() without metadata = %v, want: %v", c, CriticalityDefault)
+	}
+}
+
+func TestFromOutgoingContext(t *testing.T) {
+	t.Parallel()
+
+	for _, criticality := range []Criticality{CriticalitySheddable, CriticalityDefault, CriticalityCritical} {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), MetadataKey, criticality.String())
+
+		if c := FromOutgoingContext(ctx); c != criticality {
+			t.Errorf("FromOutgoingContext() = %v, want: %v", c, criticality)
+		}
+	}
+
+	if c := FromOutgoingContext(context.Background()); c != CriticalityDefault {
+		t.Errorf("FromOutgoingContext() without metadata = %v, want: %v", c, CriticalityDefault)
+	}
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	t.Parallel()
+
+	l := New(&config.ConcurrencyLimit{Limit: 1, Exempt: []string{"/test.Watch/"}})
+	interceptor := StreamServerInterceptor(l)
+
+	if !l.Admit(CriticalityCritical) {
+		t.Fatal("Admit() of the first call failed")
+	}
+
+	tests := []struct {
+		method   string
+		expected error
+	}{
+		{"/xds.service.orca.v3.OpenRcaService/StreamCoreMetrics", nil},
+		{"/test.Watch/Watch", nil},
+		{"/test.Service/Method", errOverloaded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			t.Parallel()
+
+			err := interceptor(nil, testServerStream{}, &grpc.StreamServerInfo{FullMethod: tt.method},
+				func(any, grpc.ServerStream) error { return nil })
+
+			if err != tt.expected {
+				t.Errorf("interceptor error = %v, want: %v", err, tt.expected)
+			}
+		})
+	}
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+}
+
+func (testServerStream) Context() context.Context {
+	return context.Background()
+}
+
+func (testServerStream) SetTrailer(metadata.MD) {}
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admission
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the metadata key of the call criticality in request headers.
+const MetadataKey = "rpcplatform-criticality"
+
+// Criticality is the importance of a call used to decide which calls are shed first.
+type Criticality int
+
+const (
+	// CriticalitySheddable calls are admitted while less than half of the limit is in use.
+	CriticalitySheddable Criticality = iota - 1
+
+	// CriticalityDefault calls are admitted while less than 90% of the limit is in use.
+	CriticalityDefault
+
+	// CriticalityCritical calls are admitted up to the limit.
+	CriticalityCritical
+)
+
+func (c Criticality) String() string {
+	switch c {
+	case CriticalitySheddable:
+		return "sheddable"
+	case CriticalityDefault:
+		return "default"
+	case CriticalityCritical:
+		return "critical"
+	default:
+		return "unknown"
+	}
+}
+
+// share returns the part of the limit available to calls with the criticality.
+func (c Criticality) share() float64 {
+	switch c {
+	case CriticalitySheddable:
+		return 0.5
+	case CriticalityCritical:
+		return 1
+	default:
+		return 0.9
+	}
+}
+
+// FromIncomingContext returns the criticality of the call. Unknown values are treated as CriticalityDefault.
+func FromIncomingContext(ctx context.Context) Criticality {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return fromMetadata(md)
+}
+
+// FromOutgoingContext returns the criticality that is sent with calls made with ctx.
+// Unknown values are treated as CriticalityDefault.
+func FromOutgoingContext(ctx context.Context) Criticality {
+	if ctx == nil {
+		return CriticalityDefault
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	return fromMetadata(md)
+}
+
+func fromMetadata(md metadata.MD) Criticality {
+	if values := md.Get(MetadataKey); len(values) != 0 {
+		for _, c := range []Criticality{CriticalitySheddable, CriticalityCritical} {
+			if values[len(values)-1] == c.String() {
+				return c
+			}
+		}
+	}
+
+	return CriticalityDefault
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admission
+
+import (
+	"strings"
+)
+
+// orcaService is the service of ORCA out-of-band load reports. Clients that request load reports
+// keep its stream open for the lifetime of their connections, so it is never counted against the limit.
+const orcaService = "/xds.service.orca.v3.OpenRcaService/"
+
+// exemptMethods returns the set of methods and services that are not counted against the limit.
+func exemptMethods(methods []string) map[string]struct{} {
+	exempt := map[string]struct{}{orcaService: {}}
+	for _, method := range methods {
+		exempt[method] = struct{}{}
+	}
+
+	return exempt
+}
+
+// isExempt reports whether the full method name, such as "/pkg.Service/Method", or its service is exempt.
+func isExempt(exempt map[string]struct{}, fullMethod string) bool {
+	if _, ok := exempt[fullMethod]; ok {
+		return true
+	}
+
+	if i := strings.LastIndex(fullMethod, "/"); i > 0 {
+		_, ok := exempt[fullMethod[:i+1]]
+		return ok
+	}
+
+	return false
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admission
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// PushbackKey is the metadata key of the delay in milliseconds that clients wait before retrying a shed call
+// or sending more calls to the server.
+const PushbackKey = "grpc-retry-pushback-ms"
+
+// UnaryServerInterceptor sheds calls that the limiter does not admit.
+func UnaryServerInterceptor(l *Limiter) grpc.UnaryServerInterceptor {
+	pushback := metadata.Pairs(PushbackKey, strconv.FormatInt(l.config.PushbackDelay.Milliseconds(), 10))
+	exempt := exemptMethods(l.config.Exempt)
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if isExempt(exempt, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		if !l.Admit(FromIncomingContext(ctx)) {
+			grpc.SetTrailer(ctx, pushback)
+			return nil, errOverloaded
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		l.Release(time.Since(start), status.Code(err))
+
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor sheds streams that the limiter does not admit.
+func StreamServerInterceptor(l *Limiter) grpc.StreamServerInterceptor {
+	pushback := metadata.Pairs(PushbackKey, strconv.FormatInt(l.config.PushbackDelay.Milliseconds(), 10))
+	exempt := exemptMethods(l.config.Exempt)
+
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isExempt(exempt, info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		if !l.Admit(FromIncomingContext(ss.Context())) {
+			ss.SetTrailer(pushback)
+			return errOverloaded
+		}
+
+		// The duration of a stream depends on its protocol rather than on the server load.
+		err := handler(srv, ss)
+		l.Release(0, status.Code(err))
+
+		return err
+	}
+}
@@ -0,0 +1,40 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admission
+
+import (
+	"sync"
+
+	"github.com/nexcode/rpcplatform/internal/config"
+)
+
+// New returns a limiter of concurrent calls with the given settings.
+func New(concurrencyLimit *config.ConcurrencyLimit) *Limiter {
+	return &Limiter{
+		config: concurrencyLimit,
+		limit:  float64(concurrencyLimit.Limit),
+	}
+}
+
+// Limiter admits calls while the number of calls in flight is below the limit for their criticality.
+type Limiter struct {
+	config *config.ConcurrencyLimit
+
+	mu       sync.Mutex
+	limit    float64
+	inflight int
+}
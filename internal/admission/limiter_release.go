@@ -0,0 +1,50 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admission
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Release completes an admitted call and, in adaptive mode, adjusts the limit based on its duration and status.
+func (l *Limiter) Release(duration time.Duration, code codes.Code) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	inflight := l.inflight
+	l.inflight--
+
+	if !l.config.Adaptive {
+		return
+	}
+
+	overloaded := code == codes.DeadlineExceeded || code == codes.ResourceExhausted ||
+		l.config.LatencyThreshold > 0 && duration > l.config.LatencyThreshold
+
+	switch {
+	case overloaded:
+		l.limit = max(l.limit*l.config.BackoffRatio, float64(l.config.MinLimit), 1)
+	case float64(inflight*2) >= l.limit:
+		l.limit = l.limit + 1
+
+		if l.config.MaxLimit > 0 {
+			l.limit = min(l.limit, float64(l.config.MaxLimit))
+		}
+	}
+}
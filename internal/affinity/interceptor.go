@@ -0,0 +1,64 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package affinity
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// UnaryServerInterceptor adds the affinity token of the server to the trailer
+// of calls that do not already present it.
+func UnaryServerInterceptor(serverID string) grpc.UnaryServerInterceptor {
+	token := Token(serverID)
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !presented(ctx, token) {
+			grpc.SetTrailer(ctx, metadata.Pairs(MetadataKey, token))
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor adds the affinity token of the server to the trailer
+// of streams that do not already present it.
+func StreamServerInterceptor(serverID string) grpc.StreamServerInterceptor {
+	token := Token(serverID)
+
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !presented(ss.Context(), token) {
+			ss.SetTrailer(metadata.Pairs(MetadataKey, token))
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func presented(ctx context.Context, token string) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	for _, value := range md.Get(MetadataKey) {
+		if value == token {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package affinity
+
+import (
+	"context"
+	"encoding/base64"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the metadata key of affinity tokens in response trailers and request headers.
+const MetadataKey = "rpcplatform-affinity"
+
+// Token returns the opaque affinity token for the server with the given ID.
+func Token(serverID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(serverID))
+}
+
+// ServerID returns the ID of the server that issued the token.
+func ServerID(token string) (string, bool) {
+	serverID, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(serverID) == 0 {
+		return "", false
+	}
+
+	return string(serverID), true
+}
+
+// FromOutgoingContext returns the server ID from the affinity token presented by a client call.
+func FromOutgoingContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if tokens := md.Get(MetadataKey); len(tokens) != 0 {
+		return ServerID(tokens[len(tokens)-1])
+	}
+
+	return "", false
+}
@@ -25,4 +25,8 @@ func New() *Attributes {
 type Attributes struct {
 	BalancerPriority int
 	BalancerWeight   int
+
+	// Labels are arbitrary server properties, such as version,
+	// that clients can use to select servers.
+	Labels map[string]string
 }
@@ -19,4 +19,5 @@ package attributes
 const (
 	balancerPriority = "balancerPriority"
 	balancerWeight   = "balancerWeight"
+	labelsPrefix     = "labels/"
 )
@@ -16,7 +16,10 @@
 
 package attributes
 
-import "strconv"
+import (
+	"strconv"
+	"strings"
+)
 
 func Load(attrs *Attributes, key, value string) {
 	switch key {
@@ -28,5 +31,13 @@ func Load(attrs *Attributes, key, value string) {
 		if v, err := strconv.Atoi(value); err == nil {
 			attrs.BalancerWeight = v
 		}
+	default:
+		if label, ok := strings.CutPrefix(key, labelsPrefix); ok {
+			if attrs.Labels == nil {
+				attrs.Labels = make(map[string]string)
+			}
+
+			attrs.Labels[label] = value
+		}
 	}
 }
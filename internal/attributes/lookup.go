@@ -0,0 +1,32 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package attributes
+
+import "strconv"
+
+// Lookup returns the value of the attribute or label with the given key.
+func Lookup(attrs *Attributes, key string) (string, bool) {
+	switch key {
+	case balancerPriority:
+		return strconv.Itoa(attrs.BalancerPriority), true
+	case balancerWeight:
+		return strconv.Itoa(attrs.BalancerWeight), true
+	}
+
+	value, ok := attrs.Labels[key]
+	return value, ok
+}
@@ -16,11 +16,21 @@
 
 package attributes
 
-import "strconv"
+import (
+	"maps"
+	"slices"
+	"strconv"
+)
 
 func Values(attrs *Attributes) []string {
-	return []string{
+	values := []string{
 		balancerPriority, strconv.Itoa(attrs.BalancerPriority),
 		balancerWeight, strconv.Itoa(attrs.BalancerWeight),
 	}
+
+	for _, label := range slices.Sorted(maps.Keys(attrs.Labels)) {
+		values = append(values, labelsPrefix+label, attrs.Labels[label])
+	}
+
+	return values
 }
@@ -17,6 +17,7 @@
 package balancer
 
 import (
+	"github.com/nexcode/rpcplatform/internal/balancer/picker"
 	"google.golang.org/grpc/balancer"
 	"google.golang.org/grpc/balancer/endpointsharding"
 	"google.golang.org/grpc/balancer/pickfirst"
@@ -27,6 +28,8 @@ func (builder) Build(cc balancer.ClientConn, opts balancer.BuildOptions) balance
 		ClientConn: cc,
 	}
 
+	b.endpoints = picker.NewEndpoints(b.refresh)
+
 	childBuilder := balancer.Get(pickfirst.Name).Build
 	b.Balancer = endpointsharding.NewBalancer(b, opts, childBuilder, endpointsharding.Options{})
 
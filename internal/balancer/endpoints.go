@@ -0,0 +1,35 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package balancer
+
+import (
+	"github.com/nexcode/rpcplatform/internal/balancer/picker"
+	"google.golang.org/grpc/attributes"
+)
+
+type endpointsKey struct{}
+
+// SetEndpoints returns a copy of attrs with the per-server state registry
+// that the balancer uses instead of its own, so the client can observe it.
+func SetEndpoints(attrs *attributes.Attributes, endpoints *picker.Endpoints) *attributes.Attributes {
+	return attrs.WithValue(endpointsKey{}, endpoints)
+}
+
+func getEndpoints(attrs *attributes.Attributes) *picker.Endpoints {
+	endpoints, _ := attrs.Value(endpointsKey{}).(*picker.Endpoints)
+	return endpoints
+}
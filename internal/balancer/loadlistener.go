@@ -0,0 +1,32 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package balancer
+
+import (
+	v3orcapb "github.com/cncf/xds/go/xds/data/orca/v3"
+	"github.com/nexcode/rpcplatform/internal/balancer/picker"
+)
+
+// loadListener passes out-of-band load reports of a single server to the endpoint states.
+type loadListener struct {
+	endpoints *picker.Endpoints
+	id        string
+}
+
+func (l *loadListener) OnLoadReport(report *v3orcapb.OrcaLoadReport) {
+	l.endpoints.Report(l.id, report)
+}
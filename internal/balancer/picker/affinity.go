@@ -0,0 +1,50 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"google.golang.org/grpc/balancer"
+)
+
+// newAffinityPicker returns a picker that routes calls presenting an affinity token
+// to the ready server that issued it and balances other calls with the given picker.
+// Ready servers excluded from balancing, such as ejected ones, keep their calls,
+// which wait until the server becomes available again.
+func newAffinityPicker(picker balancer.Picker, pickerStates []*state, excluded []*endpointPicker) *affinityPicker {
+	affinityPicker := &affinityPicker{
+		Picker:   picker,
+		pickers:  make(map[string]*endpointPicker, len(pickerStates)+len(excluded)),
+		excluded: make(map[string]struct{}, len(excluded)),
+	}
+
+	for _, pickerState := range pickerStates {
+		affinityPicker.pickers[pickerState.id] = pickerState.picker
+	}
+
+	for _, endpointPicker := range excluded {
+		affinityPicker.pickers[endpointPicker.id] = endpointPicker
+		affinityPicker.excluded[endpointPicker.id] = struct{}{}
+	}
+
+	return affinityPicker
+}
+
+type affinityPicker struct {
+	balancer.Picker
+	pickers  map[string]*endpointPicker
+	excluded map[string]struct{}
+}
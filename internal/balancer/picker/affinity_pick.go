@@ -0,0 +1,41 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"github.com/nexcode/rpcplatform/internal/admission"
+	"github.com/nexcode/rpcplatform/internal/affinity"
+	"github.com/nexcode/rpcplatform/internal/retry"
+	"github.com/nexcode/rpcplatform/internal/routing"
+	"google.golang.org/grpc/balancer"
+)
+
+func (p *affinityPicker) Pick(pickInfo balancer.PickInfo) (balancer.PickResult, error) {
+	if id, ok := affinity.FromOutgoingContext(pickInfo.Ctx); ok {
+		if picker := p.pickers[id]; picker != nil && !retry.FromContext(pickInfo.Ctx).Tried(id) {
+			if routing.FromContext(pickInfo.Ctx).Match(picker.id, picker.attributes) {
+				if _, excluded := p.excluded[id]; excluded || !picker.available(admission.FromOutgoingContext(pickInfo.Ctx)) {
+					return balancer.PickResult{}, errAffinityServerUnavailable
+				}
+
+				return picker.Pick(pickInfo)
+			}
+		}
+	}
+
+	return p.Picker.Pick(pickInfo)
+}
@@ -0,0 +1,144 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"time"
+
+	"github.com/nexcode/rpcplatform/internal/config"
+)
+
+// CircuitState is the state of the circuit breaker of a server.
+type CircuitState int
+
+const (
+	CircuitClosed CircuitState = iota
+	CircuitOpen
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+type breaker struct {
+	active              int
+	requests            int
+	failures            int
+	consecutiveFailures int
+	intervalStart       time.Time
+	openUntil           time.Time
+}
+
+// state returns the state of the breaker at the given time.
+// An open breaker becomes half-open when its open time expires and stays so until a probe call completes.
+func (b *breaker) state(now time.Time) CircuitState {
+	switch {
+	case b.openUntil.IsZero():
+		return CircuitClosed
+	case b.openUntil.After(now):
+		return CircuitOpen
+	default:
+		return CircuitHalfOpen
+	}
+}
+
+// open opens the breaker until the given time and resets the call counters.
+func (b *breaker) open(until time.Time) {
+	b.openUntil = until
+	b.requests = 0
+	b.failures = 0
+	b.consecutiveFailures = 0
+}
+
+// close closes the breaker and starts a new interval.
+func (b *breaker) close(now time.Time) {
+	b.openUntil = time.Time{}
+	b.intervalStart = now
+}
+
+// available reports whether the server can accept another call at the given time.
+func (b *breaker) available(circuitBreaker *config.CircuitBreaker, now time.Time) bool {
+	switch b.state(now) {
+	case CircuitOpen:
+		return false
+	case CircuitHalfOpen:
+		return b.active < max(circuitBreaker.HalfOpenRequests, 1)
+	default:
+		return circuitBreaker.MaxConcurrentRequests <= 0 || b.active < circuitBreaker.MaxConcurrentRequests
+	}
+}
+
+// release accounts the end of a call and reports whether the server became available.
+func (b *breaker) release(circuitBreaker *config.CircuitBreaker, now time.Time) bool {
+	full := !b.available(circuitBreaker, now)
+	b.active = max(b.active-1, 0)
+
+	return full
+}
+
+// observe accounts a completed call and reports whether the server became available or unavailable.
+func (b *breaker) observe(circuitBreaker *config.CircuitBreaker, failed bool, now time.Time) bool {
+	full := b.release(circuitBreaker, now)
+
+	switch b.state(now) {
+	case CircuitOpen:
+		return false
+	case CircuitHalfOpen:
+		if failed {
+			b.open(now.Add(circuitBreaker.OpenTime))
+			return true
+		}
+
+		b.close(now)
+		return true
+	}
+
+	if now.Sub(b.intervalStart) >= circuitBreaker.Interval {
+		b.requests = 0
+		b.failures = 0
+		b.intervalStart = now
+	}
+
+	b.requests++
+
+	if !failed {
+		b.consecutiveFailures = 0
+		return full
+	}
+
+	b.failures++
+	b.consecutiveFailures++
+
+	if circuitBreaker.ConsecutiveFailures > 0 && b.consecutiveFailures >= circuitBreaker.ConsecutiveFailures ||
+		circuitBreaker.MaxErrorRate > 0 && b.requests >= circuitBreaker.MinRequests &&
+			float64(b.failures) >= circuitBreaker.MaxErrorRate*float64(b.requests) {
+		b.open(now.Add(circuitBreaker.OpenTime))
+		return true
+	}
+
+	return full
+}
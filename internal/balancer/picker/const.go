@@ -0,0 +1,38 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"time"
+)
+
+const (
+	// weightScale is the resolution of effective server weights.
+	weightScale = 100
+
+	// maxTotalWeight limits the sum of effective server weights, which is the length of the picker sequence.
+	// The resolution of effective weights is lowered down to the server weights for targets with a higher sum.
+	maxTotalWeight = 1000
+
+	slowStartMinFactor = 0.1
+	slowStartInterval  = time.Second
+
+	latencyInterval = time.Second
+
+	loadInterval   = time.Second
+	loadExpiration = 3 * time.Minute
+)
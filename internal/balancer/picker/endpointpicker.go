@@ -0,0 +1,39 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"github.com/nexcode/rpcplatform/internal/attributes"
+	"github.com/nexcode/rpcplatform/internal/config"
+	"google.golang.org/grpc/balancer"
+)
+
+// endpointPicker wraps the picker of a single server and reports call results to the endpoint state.
+type endpointPicker struct {
+	balancer.Picker
+	id         string
+	attributes *attributes.Attributes
+	endpoints  *Endpoints
+	endpoint   *endpoint
+	config     *config.Client
+}
+
+// tracksCalls reports whether call results are required by the client configuration.
+func tracksCalls(config *config.Client) bool {
+	return config.OutlierDetection != nil || config.CircuitBreaker != nil ||
+		config.LatencyWeighting > 0 || config.LoadReporting != nil
+}
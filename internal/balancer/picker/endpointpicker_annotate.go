@@ -0,0 +1,44 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"context"
+
+	"github.com/nexcode/rpcplatform/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// annotate records the chosen server on the span of the call and in the pick metrics.
+func (p *endpointPicker) annotate(ctx context.Context, retried bool) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() && p.endpoints.metrics == nil {
+		return
+	}
+
+	attributes := []attribute.KeyValue{
+		telemetry.TargetKey.String(p.endpoints.target),
+		telemetry.ServerIDKey.String(p.id),
+		telemetry.PriorityKey.Int(p.attributes.BalancerPriority),
+		telemetry.WeightKey.Int(p.attributes.BalancerWeight),
+		telemetry.RetryKey.Bool(retried),
+	}
+
+	span.SetAttributes(attributes...)
+	p.endpoints.metrics.Pick(ctx, attributes...)
+}
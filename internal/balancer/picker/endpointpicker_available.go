@@ -0,0 +1,42 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"time"
+
+	"github.com/nexcode/rpcplatform/internal/admission"
+)
+
+// available reports whether the server did not push back calls with the criticality
+// and its circuit breaker lets another call through.
+func (p *endpointPicker) available(criticality admission.Criticality) bool {
+	now := time.Now()
+
+	if p.endpoint.pushedBack(criticality, now) {
+		return false
+	}
+
+	if p.config.CircuitBreaker == nil {
+		return true
+	}
+
+	p.endpoints.mu.Lock()
+	defer p.endpoints.mu.Unlock()
+
+	return p.endpoint.breaker.available(p.config.CircuitBreaker, now)
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"time"
+
+	"github.com/nexcode/rpcplatform/internal/admission"
+	"github.com/nexcode/rpcplatform/internal/retry"
+	"google.golang.org/grpc/balancer"
+)
+
+func (p *endpointPicker) Pick(pickInfo balancer.PickInfo) (balancer.PickResult, error) {
+	result, err := p.Picker.Pick(pickInfo)
+	if err != nil {
+		return result, err
+	}
+
+	tracker := retry.FromContext(pickInfo.Ctx)
+	p.annotate(pickInfo.Ctx, tracker.Attempted())
+
+	if p.config.CircuitBreaker != nil {
+		p.endpoints.acquire(p.endpoint)
+	}
+
+	criticality, start := admission.FromOutgoingContext(pickInfo.Ctx), time.Now()
+	done := result.Done
+
+	result.Done = func(doneInfo balancer.DoneInfo) {
+		// gRPC abandons picks of servers whose transports are not ready and picks again,
+		// so the server is tried only when the call reached it.
+		if doneInfo.BytesSent || doneInfo.BytesReceived {
+			tracker.Add(p.id)
+		}
+
+		if p.endpoint.pushBack(criticality, doneInfo.Trailer, time.Now()) {
+			p.endpoints.changed()
+		}
+
+		if tracksCalls(p.config) {
+			p.endpoints.record(p.endpoint, p.config, time.Since(start), doneInfo)
+		}
+
+		if done != nil {
+			done(doneInfo)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/nexcode/rpcplatform/internal/telemetry"
+)
+
+// NewEndpoints returns an empty per-server state registry.
+// The registry outlives individual pickers and must be updated before each New call.
+// The onChange function is called asynchronously when call results require the picker to be rebuilt.
+func NewEndpoints(onChange func()) *Endpoints {
+	return &Endpoints{
+		endpoints: make(map[string]*endpoint),
+		onChange:  onChange,
+	}
+}
+
+type Endpoints struct {
+	mu        sync.Mutex
+	endpoints map[string]*endpoint
+	onChange  func()
+	evaluated time.Time
+
+	// target and metrics are set before the registry is shared and never change.
+	target  string
+	metrics *telemetry.Metrics
+}
+
+type endpoint struct {
+	readySince time.Time
+	outlier    outlier
+	breaker    breaker
+	latency    latency
+	load       load
+
+	// pushbackUntil holds the end of the pushback for each call criticality starting from CriticalitySheddable.
+	// It is accessed without holding Endpoints.mu.
+	pushbackUntil [3]atomic.Int64
+}
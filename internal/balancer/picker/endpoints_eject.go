@@ -0,0 +1,53 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"time"
+
+	"github.com/nexcode/rpcplatform/internal/config"
+)
+
+// eject removes the server from rotation unless the maximum ejection percentage is reached.
+// Each subsequent ejection doubles the ejection time. It must be called with e.mu held.
+func (e *Endpoints) eject(endpoint *endpoint, outlierDetection *config.OutlierDetection, now time.Time) bool {
+	if len(e.endpoints) < 2 {
+		return false
+	}
+
+	ejected := 0
+	for _, endpoint := range e.endpoints {
+		if endpoint.outlier.ejected(now) {
+			ejected++
+		}
+	}
+
+	if ejected*100 >= len(e.endpoints)*outlierDetection.MaxEjectionPercent {
+		return false
+	}
+
+	ejectionTime := outlierDetection.BaseEjectionTime << min(endpoint.outlier.ejections, 30)
+	if ejectionTime <= 0 || ejectionTime > outlierDetection.MaxEjectionTime {
+		ejectionTime = outlierDetection.MaxEjectionTime
+	}
+
+	endpoint.outlier.ejections++
+	endpoint.outlier.ejectedUntil = now.Add(ejectionTime)
+	endpoint.outlier.consecutiveErrors = 0
+
+	return true
+}
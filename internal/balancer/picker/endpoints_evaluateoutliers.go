@@ -0,0 +1,51 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/nexcode/rpcplatform/internal/config"
+)
+
+// evaluateOutliers ejects servers whose success rate over the last interval is too low,
+// starting with the lowest rate, and resets the call counters. It must be called with e.mu held.
+func (e *Endpoints) evaluateOutliers(outlierDetection *config.OutlierDetection, now time.Time) {
+	endpoints := slices.SortedFunc(maps.Values(e.endpoints), func(a, b *endpoint) int {
+		return cmp.Compare(a.outlier.successRate(), b.outlier.successRate())
+	})
+
+	for _, endpoint := range endpoints {
+		outlier := &endpoint.outlier
+		requests := outlier.successes + outlier.failures
+
+		switch {
+		case outlier.ejected(now):
+		case outlierDetection.MinSuccessRate > 0 && requests > 0 && requests >= outlierDetection.MinRequests &&
+			outlier.successRate() < outlierDetection.MinSuccessRate:
+			e.eject(endpoint, outlierDetection, now)
+		case outlier.ejections > 0:
+			outlier.ejections--
+		}
+
+		outlier.successes = 0
+		outlier.failures = 0
+	}
+}
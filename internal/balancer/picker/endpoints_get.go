@@ -0,0 +1,27 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+// get returns the state of the server with the given ID.
+// It must be called with e.mu held.
+func (e *Endpoints) get(id string) *endpoint {
+	if endpoint := e.endpoints[id]; endpoint != nil {
+		return endpoint
+	}
+
+	return &endpoint{}
+}
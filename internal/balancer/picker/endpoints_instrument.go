@@ -0,0 +1,27 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"github.com/nexcode/rpcplatform/internal/telemetry"
+)
+
+// Instrument makes pickers built from the registry record metrics for the target.
+// It must be called before the registry is passed to the balancer.
+func (e *Endpoints) Instrument(target string, metrics *telemetry.Metrics) {
+	e.target, e.metrics = target, metrics
+}
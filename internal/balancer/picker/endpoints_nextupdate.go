@@ -0,0 +1,90 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"time"
+
+	"github.com/nexcode/rpcplatform/internal/config"
+)
+
+// NextUpdate returns the duration after which the picker must be rebuilt
+// because effective server weights, ejections, pushbacks or circuit breaker states change over time.
+// It returns 0 if the picker does not need to be rebuilt.
+func (e *Endpoints) NextUpdate(config *config.Client, now time.Time) time.Duration {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var next time.Duration
+
+	if config.SlowStart != nil && config.SlowStart.Window > 0 {
+		for _, endpoint := range e.endpoints {
+			if !endpoint.readySince.IsZero() && now.Sub(endpoint.readySince) < config.SlowStart.Window {
+				next = min(slowStartInterval, config.SlowStart.Window/10)
+				break
+			}
+		}
+	}
+
+	if config.LatencyWeighting > 0 {
+		next = minPositive(next, latencyInterval)
+	}
+
+	if config.LoadReporting != nil {
+		next = minPositive(next, loadInterval)
+	}
+
+	for _, endpoint := range e.endpoints {
+		for i := range endpoint.pushbackUntil {
+			if until := time.Unix(0, endpoint.pushbackUntil[i].Load()); until.After(now) {
+				next = minPositive(next, until.Sub(now))
+			}
+		}
+	}
+
+	if config.CircuitBreaker != nil {
+		for _, endpoint := range e.endpoints {
+			if endpoint.breaker.state(now) == CircuitOpen {
+				next = minPositive(next, endpoint.breaker.openUntil.Sub(now))
+			}
+		}
+	}
+
+	if config.OutlierDetection != nil {
+		next = minPositive(next, e.evaluated.Add(config.OutlierDetection.Interval).Sub(now))
+
+		for _, endpoint := range e.endpoints {
+			if endpoint.outlier.ejectedUntil.After(now) {
+				next = minPositive(next, endpoint.outlier.ejectedUntil.Sub(now))
+			}
+		}
+	}
+
+	return next
+}
+
+func minPositive(a, b time.Duration) time.Duration {
+	if a <= 0 {
+		return max(b, 0)
+	}
+
+	if b <= 0 {
+		return a
+	}
+
+	return min(a, b)
+}
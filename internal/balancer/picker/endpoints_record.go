@@ -0,0 +1,90 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"slices"
+	"time"
+
+	v3orcapb "github.com/cncf/xds/go/xds/data/orca/v3"
+	"github.com/nexcode/rpcplatform/internal/config"
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// record accounts the result of a call made to the server. It updates the average call duration,
+// the reported load and the circuit breaker, and ejects the server when the number of consecutive
+// failed calls reaches the threshold. The picker is rebuilt when the server becomes available
+// or unavailable, so that calls waiting for ready are picked again.
+func (e *Endpoints) record(endpoint *endpoint, config *config.Client, duration time.Duration, doneInfo balancer.DoneInfo) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := time.Now()
+
+	// Calls that sent and received nothing were abandoned by gRPC because the transport was not ready.
+	if !doneInfo.BytesSent && !doneInfo.BytesReceived {
+		if config.CircuitBreaker != nil && endpoint.breaker.release(config.CircuitBreaker, now) && e.onChange != nil {
+			go e.onChange()
+		}
+
+		return
+	}
+
+	code := status.Code(doneInfo.Err)
+
+	if report, ok := doneInfo.ServerLoad.(*v3orcapb.OrcaLoadReport); ok && config.LoadReporting != nil {
+		endpoint.load.observe(report, now)
+	}
+
+	if config.LatencyWeighting > 0 && (code == codes.OK || code == codes.DeadlineExceeded) {
+		endpoint.latency.observe(duration, config.LatencyWeighting, now)
+	}
+
+	if circuitBreaker := config.CircuitBreaker; circuitBreaker != nil {
+		failed := slices.Contains(circuitBreaker.ErrorCodes, code)
+
+		if endpoint.breaker.observe(circuitBreaker, failed, now) && e.onChange != nil {
+			go e.onChange()
+		}
+	}
+
+	outlierDetection := config.OutlierDetection
+	if outlierDetection == nil {
+		return
+	}
+
+	outlier := &endpoint.outlier
+
+	if !slices.Contains(outlierDetection.ErrorCodes, code) {
+		outlier.successes++
+		outlier.consecutiveErrors = 0
+		return
+	}
+
+	outlier.failures++
+	outlier.consecutiveErrors++
+
+	if outlierDetection.ConsecutiveErrors <= 0 || outlier.consecutiveErrors < outlierDetection.ConsecutiveErrors {
+		return
+	}
+
+	if !outlier.ejected(now) && e.eject(endpoint, outlierDetection, now) && e.onChange != nil {
+		go e.onChange()
+	}
+}
@@ -0,0 +1,33 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"time"
+
+	v3orcapb "github.com/cncf/xds/go/xds/data/orca/v3"
+)
+
+// Report updates the weight of the server with the given ID from an out-of-band load report.
+func (e *Endpoints) Report(id string, report *v3orcapb.OrcaLoadReport) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if endpoint := e.endpoints[id]; endpoint != nil {
+		endpoint.load.observe(report, time.Now())
+	}
+}
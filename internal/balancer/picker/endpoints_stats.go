@@ -0,0 +1,43 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"time"
+
+	"github.com/nexcode/rpcplatform/internal/admission"
+)
+
+// Stats returns the balancing state of each server keyed by server ID.
+func (e *Endpoints) Stats() map[string]*EndpointStats {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := time.Now()
+	stats := make(map[string]*EndpointStats, len(e.endpoints))
+
+	for id, endpoint := range e.endpoints {
+		stats[id] = &EndpointStats{
+			Ejected:        endpoint.outlier.ejected(now),
+			PushedBack:     endpoint.pushedBack(admission.CriticalitySheddable, now),
+			CircuitState:   endpoint.breaker.state(now),
+			ActiveRequests: endpoint.breaker.active,
+		}
+	}
+
+	return stats
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"time"
+
+	"github.com/nexcode/rpcplatform/internal/config"
+	"github.com/nexcode/rpcplatform/internal/grpcattrs"
+	"google.golang.org/grpc/balancer/endpointsharding"
+	"google.golang.org/grpc/connectivity"
+)
+
+// Update records the time each server first became ready, forgets servers that are no longer registered
+// and evaluates outlier detection when its interval has elapsed.
+func (e *Endpoints) Update(childStates []endpointsharding.ChildState, config *config.Client, now time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	ids := make(map[string]struct{}, len(childStates))
+
+	for _, childState := range childStates {
+		id := grpcattrs.GetServerID(childState.Endpoint.Attributes)
+		ids[id] = struct{}{}
+
+		if e.endpoints[id] == nil {
+			e.endpoints[id] = &endpoint{}
+		}
+
+		if childState.State.ConnectivityState == connectivity.Ready && e.endpoints[id].readySince.IsZero() {
+			e.endpoints[id].readySince = now
+		}
+	}
+
+	for id := range e.endpoints {
+		if _, ok := ids[id]; !ok {
+			delete(e.endpoints, id)
+		}
+	}
+
+	if config.OutlierDetection != nil && now.Sub(e.evaluated) >= config.OutlierDetection.Interval {
+		e.evaluateOutliers(config.OutlierDetection, now)
+		e.evaluated = now
+	}
+}
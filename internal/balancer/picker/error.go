@@ -16,6 +16,24 @@
 
 package picker
 
-import "errors"
+import (
+	"errors"
 
-var errNoServerAvailableForPick = errors.New("no server available for pick")
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrNoServers is returned by calls when the target has no registered servers.
+// The picker returns errNoServers instead, which is not a status error, so that calls
+// with WaitForReady block until a server is registered; gRPC converts it to ErrNoServers for the others.
+var ErrNoServers = status.Error(codes.Unavailable, errNoServers.Error())
+
+// Picker errors are not status errors, so gRPC retries calls that failed with them
+// and lets calls with WaitForReady wait for the next picker.
+var (
+	errNoServers                 = errors.New("no servers registered")
+	errNoServerAvailableForPick  = errors.New("no server available for pick")
+	errNoServerMatchesHints      = errors.New("no server matches routing hints")
+	errAffinityServerUnavailable = errors.New("server of the affinity token is temporarily unavailable")
+	errServersUnavailable        = errors.New("all matching servers are pushed back, at their concurrency limits or have open circuit breakers")
+)
@@ -0,0 +1,102 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"github.com/nexcode/rpcplatform/internal/config"
+	"google.golang.org/grpc/balancer"
+)
+
+func newFailoverPicker(pickerStates []*state, registered map[int]int, config *config.Client, ready []*endpointPicker) balancer.Picker {
+	var tiers [][]*state
+
+	for i, pickerState := range pickerStates {
+		if i == 0 || pickerState.priority != pickerStates[i-1].priority {
+			tiers = append(tiers, nil)
+		}
+
+		tiers[len(tiers)-1] = append(tiers[len(tiers)-1], pickerState)
+	}
+
+	picker := &failoverPicker{
+		ready: ready,
+	}
+	remaining := 1.0
+	active := 0
+
+	for _, tier := range tiers {
+		if remaining <= 0 {
+			break
+		}
+
+		if config.MaxActiveServers > 0 && active == config.MaxActiveServers {
+			break
+		}
+
+		load := min(remaining, tierHealth(len(tier), registered[tier[0].priority], config.PriorityFailover))
+		remaining -= load
+
+		if config.MaxActiveServers > 0 {
+			tier = tier[:min(len(tier), config.MaxActiveServers-active)]
+			active += len(tier)
+		}
+
+		picker.pickers = append(picker.pickers, newPicker(tier))
+		picker.loads = append(picker.loads, load)
+	}
+
+	if len(picker.pickers) == 1 {
+		picker.pickers[0].ready = ready
+		return picker.pickers[0]
+	}
+
+	var cumulative float64
+	total := 1 - max(remaining, 0)
+
+	for i, load := range picker.loads {
+		cumulative += load / total
+		picker.loads[i] = cumulative
+	}
+
+	return picker
+}
+
+// tierHealth returns the share of traffic in the range [0, 1] that a priority tier
+// with the given number of ready and registered servers is able to accept.
+func tierHealth(ready, total int, failover *config.PriorityFailover) float64 {
+	if failover.MinHealthyPercent <= 0 && failover.MinHealthyCount <= 0 {
+		return 1
+	}
+
+	var health float64
+
+	if failover.MinHealthyPercent > 0 {
+		health = float64(ready*100) / float64(total*failover.MinHealthyPercent)
+	}
+
+	if failover.MinHealthyCount > 0 {
+		health = max(health, float64(ready)/float64(failover.MinHealthyCount))
+	}
+
+	return min(health, 1)
+}
+
+type failoverPicker struct {
+	pickers []*picker
+	loads   []float64
+	ready   []*endpointPicker
+}
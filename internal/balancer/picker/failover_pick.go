@@ -0,0 +1,51 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"math/rand/v2"
+
+	"github.com/nexcode/rpcplatform/internal/admission"
+	"github.com/nexcode/rpcplatform/internal/retry"
+	"github.com/nexcode/rpcplatform/internal/routing"
+	"google.golang.org/grpc/balancer"
+)
+
+func (p *failoverPicker) Pick(pickInfo balancer.PickInfo) (balancer.PickResult, error) {
+	r := rand.Float64()
+	i := len(p.pickers) - 1
+
+	for j, load := range p.loads {
+		if r < load {
+			i = j
+			break
+		}
+	}
+
+	hints, tracker := routing.FromContext(pickInfo.Ctx), retry.FromContext(pickInfo.Ctx)
+
+	if hints == nil && !tracker.Attempted() {
+		if picker := p.pickers[i].nextPicker(); picker.available(admission.FromOutgoingContext(pickInfo.Ctx)) {
+			return picker.Pick(pickInfo)
+		}
+	}
+
+	pickers := append([]*picker{p.pickers[i]}, p.pickers[:i]...)
+	pickers = append(pickers, p.pickers[i+1:]...)
+
+	return pickMatching(pickInfo, hints, tracker, pickers, p.ready)
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"math/rand/v2"
+	"slices"
+
+	"github.com/nexcode/rpcplatform/internal/admission"
+	"github.com/nexcode/rpcplatform/internal/retry"
+	"github.com/nexcode/rpcplatform/internal/routing"
+	"google.golang.org/grpc/balancer"
+)
+
+// pickMatching picks the next server matching the hints from the sequences in the given order.
+// If no active server matches, it picks a random matching server among all ready servers.
+// Servers tried by previous attempts of the call are skipped while other matching servers exist,
+// and servers whose circuit breakers do not let the call through are always skipped.
+func pickMatching(pickInfo balancer.PickInfo, hints *routing.Hints, tracker *retry.Tracker, pickers []*picker, ready []*endpointPicker) (balancer.PickResult, error) {
+	matches := func(p *endpointPicker) bool {
+		return hints.Match(p.id, p.attributes)
+	}
+
+	criticality := admission.FromOutgoingContext(pickInfo.Ctx)
+	available := func(p *endpointPicker) bool {
+		return matches(p) && p.available(criticality)
+	}
+
+	if tracker.Attempted() {
+		untried := func(p *endpointPicker) bool {
+			return !tracker.Tried(p.id) && available(p)
+		}
+
+		if endpointPicker := nextMatching(untried, pickers, ready); endpointPicker != nil {
+			return endpointPicker.Pick(pickInfo)
+		}
+	}
+
+	if endpointPicker := nextMatching(available, pickers, ready); endpointPicker != nil {
+		return endpointPicker.Pick(pickInfo)
+	}
+
+	if slices.ContainsFunc(ready, matches) {
+		return balancer.PickResult{}, errServersUnavailable
+	}
+
+	return balancer.PickResult{}, errNoServerMatchesHints
+}
+
+// nextMatching returns the next matching server from the sequences in the given order
+// or a random matching server among all ready servers. It returns nil if no server matches.
+func nextMatching(match func(*endpointPicker) bool, pickers []*picker, ready []*endpointPicker) *endpointPicker {
+	for _, picker := range pickers {
+		if endpointPicker := picker.nextMatching(match); endpointPicker != nil {
+			return endpointPicker
+		}
+	}
+
+	var matching []*endpointPicker
+
+	for _, endpointPicker := range ready {
+		if match(endpointPicker) {
+			matching = append(matching, endpointPicker)
+		}
+	}
+
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[rand.IntN(len(matching))]
+}
+
+// nextMatching advances the sequence to the next matching server and returns it.
+// It returns nil if no server in the sequence matches.
+func (p *picker) nextMatching(match func(*endpointPicker) bool) *endpointPicker {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i := range p.pickers {
+		j := (p.next + i) % len(p.pickers)
+
+		if match(p.pickers[j]) {
+			p.next = (j + 1) % len(p.pickers)
+			return p.pickers[j]
+		}
+	}
+
+	return nil
+}
+
+// nextPicker advances the sequence and returns the next server.
+func (p *picker) nextPicker() *endpointPicker {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	picker := p.pickers[p.next]
+	p.next = (p.next + 1) % len(p.pickers)
+
+	return picker
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"math"
+	"time"
+)
+
+type latency struct {
+	ewma    float64
+	updated time.Time
+}
+
+// observe adds a call duration to the exponentially weighted moving average.
+// The weight of previous observations decays with the given time constant.
+func (l *latency) observe(duration, decay time.Duration, now time.Time) {
+	if l.updated.IsZero() {
+		l.ewma = float64(duration)
+	} else {
+		w := math.Exp(-float64(now.Sub(l.updated)) / float64(decay))
+		l.ewma = l.ewma*w + float64(duration)*(1-w)
+	}
+
+	l.updated = now
+}
+
+// minLatency returns the lowest average call duration among the servers that have observations.
+func minLatency(pickerStates []*state) float64 {
+	var value float64
+
+	for _, pickerState := range pickerStates {
+		if ewma := pickerState.endpoint.latency.ewma; ewma > 0 && (value == 0 || ewma < value) {
+			value = ewma
+		}
+	}
+
+	return value
+}
+
+// latencyFactor returns the multiplier in the range (0, 1] applied to the weight of a server
+// in proportion to how much slower it is than the fastest server.
+func latencyFactor(decay time.Duration, latency latency, minLatency float64) float64 {
+	if decay <= 0 || latency.ewma <= 0 || minLatency <= 0 {
+		return 1
+	}
+
+	return min(minLatency/latency.ewma, 1)
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"time"
+
+	v3orcapb "github.com/cncf/xds/go/xds/data/orca/v3"
+	"github.com/nexcode/rpcplatform/internal/config"
+)
+
+type load struct {
+	weight  float64
+	updated time.Time
+}
+
+// observe calculates the server weight from a load report: the more queries per second the server handles
+// per unit of utilization, the higher its weight. Errors reduce the weight in proportion to their rate.
+// Reports without utilization or queries per second are ignored.
+func (l *load) observe(report *v3orcapb.OrcaLoadReport, now time.Time) {
+	utilization := report.GetApplicationUtilization()
+	if utilization == 0 {
+		utilization = report.GetCpuUtilization()
+	}
+
+	qps := report.GetRpsFractional()
+	if utilization <= 0 || qps <= 0 {
+		return
+	}
+
+	l.weight = qps / (utilization + report.GetEps()/qps)
+	l.updated = now
+}
+
+// fresh reports whether the server weight is based on a recent load report.
+func (l *load) fresh(now time.Time) bool {
+	return l.weight > 0 && now.Sub(l.updated) < loadExpiration
+}
+
+// loadWeights returns the highest and the mean weight calculated from recent load reports.
+func loadWeights(pickerStates []*state, now time.Time) (float64, float64) {
+	var maxWeight, sumWeight float64
+	var count int
+
+	for _, pickerState := range pickerStates {
+		if load := pickerState.endpoint.load; load.fresh(now) {
+			maxWeight = max(maxWeight, load.weight)
+			sumWeight += load.weight
+			count++
+		}
+	}
+
+	if count == 0 {
+		return 0, 0
+	}
+
+	return maxWeight, sumWeight / float64(count)
+}
+
+// loadFactor returns the multiplier in the range (0, 1] applied to the weight of a server
+// relative to the server with the highest weight calculated from load reports.
+// Servers without recent load reports are treated as having the mean weight.
+func loadFactor(loadReporting *config.LoadReporting, load load, now time.Time, maxWeight, meanWeight float64) float64 {
+	if loadReporting == nil || maxWeight <= 0 {
+		return 1
+	}
+
+	if !load.fresh(now) {
+		return meanWeight / maxWeight
+	}
+
+	return load.weight / maxWeight
+}
@@ -0,0 +1,44 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"time"
+)
+
+type outlier struct {
+	successes         int
+	failures          int
+	consecutiveErrors int
+	ejections         int
+	ejectedUntil      time.Time
+}
+
+// ejected reports whether the server is ejected at the given time.
+func (o *outlier) ejected(now time.Time) bool {
+	return o.ejectedUntil.After(now)
+}
+
+// successRate returns the share of successful calls in the current interval.
+// It returns 1 if there were no calls.
+func (o *outlier) successRate() float64 {
+	if requests := o.successes + o.failures; requests > 0 {
+		return float64(o.successes) / float64(requests)
+	}
+
+	return 1
+}
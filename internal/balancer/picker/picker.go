@@ -22,6 +22,7 @@ import (
 	"math/rand/v2"
 	"slices"
 	"sync"
+	"time"
 
 	"github.com/nexcode/rpcplatform/internal/config"
 	"github.com/nexcode/rpcplatform/internal/grpcattrs"
@@ -31,15 +32,23 @@ import (
 	"google.golang.org/grpc/connectivity"
 )
 
-func New(childStates []endpointsharding.ChildState, config *config.Client) balancer.Picker {
+func New(childStates []endpointsharding.ChildState, config *config.Client, endpoints *Endpoints) balancer.Picker {
+	endpoints.metrics.PickerRebuild(endpoints.target)
+
 	if len(childStates) == 0 {
-		return base.NewErrPicker(errNoServerAvailableForPick)
+		return base.NewErrPicker(errNoServers)
 	}
 
 	var connecting bool
-	var totalWeight int
+	now := time.Now()
 
+	endpoints.mu.Lock()
+	defer endpoints.mu.Unlock()
+
+	registered := make(map[int]int)
+	groupRegistered := make(map[string]map[int]int)
 	pickerStates := make([]*state, 0, len(childStates))
+	var excluded []*endpointPicker
 
 	for _, childState := range childStates {
 		attributes := grpcattrs.GetAttributes(childState.Endpoint.Attributes)
@@ -47,6 +56,15 @@ func New(childStates []endpointsharding.ChildState, config *config.Client) balan
 			continue
 		}
 
+		registered[attributes.BalancerPriority]++
+
+		group := splitGroup(config.TrafficSplit, attributes)
+		if groupRegistered[group] == nil {
+			groupRegistered[group] = make(map[int]int)
+		}
+
+		groupRegistered[group][attributes.BalancerPriority]++
+
 		if childState.State.ConnectivityState == connectivity.Connecting {
 			connecting = true
 		}
@@ -55,8 +73,28 @@ func New(childStates []endpointsharding.ChildState, config *config.Client) balan
 			continue
 		}
 
+		id := grpcattrs.GetServerID(childState.Endpoint.Attributes)
+		endpoint := endpoints.get(id)
+
+		endpointPicker := &endpointPicker{
+			Picker:     childState.State.Picker,
+			id:         id,
+			attributes: attributes,
+			endpoints:  endpoints,
+			endpoint:   endpoint,
+			config:     config,
+		}
+
+		if endpoint.outlier.ejected(now) || endpoint.breaker.state(now) == CircuitOpen {
+			excluded = append(excluded, endpointPicker)
+			continue
+		}
+
 		pickerStates = append(pickerStates, &state{
-			picker:   childState.State.Picker,
+			picker:   endpointPicker,
+			id:       id,
+			group:    group,
+			endpoint: endpoint,
 			priority: attributes.BalancerPriority,
 			weight:   attributes.BalancerWeight,
 		})
@@ -70,21 +108,93 @@ func New(childStates []endpointsharding.ChildState, config *config.Client) balan
 		return base.NewErrPicker(errNoServerAvailableForPick)
 	}
 
+	minLatency := minLatency(pickerStates)
+	maxLoadWeight, meanLoadWeight := loadWeights(pickerStates, now)
+
+	weights := make([]float64, len(pickerStates))
+	var totalWeight float64
+
+	for i, pickerState := range pickerStates {
+		weights[i] = float64(pickerState.weight) *
+			slowStartFactor(config.SlowStart, pickerState.endpoint.readySince, now) *
+			latencyFactor(config.LatencyWeighting, pickerState.endpoint.latency, minLatency) *
+			loadFactor(config.LoadReporting, pickerState.endpoint.load, now, maxLoadWeight, meanLoadWeight)
+
+		totalWeight += weights[i]
+	}
+
+	// The scale is an integer, so that the weights are reduced back by their greatest common divisor
+	// when no factor changes them.
+	scale := max(min(weightScale, math.Floor(maxTotalWeight/totalWeight)), 1)
+
+	for i, pickerState := range pickerStates {
+		pickerState.weight = max(int(math.Round(weights[i]*scale)), 1)
+	}
+
+	if config.Subsetting != nil {
+		for _, pickerState := range pickerStates {
+			pickerState.rank = subsetRank(config.Subsetting.ShardKey, pickerState.id)
+		}
+	}
+
+	var picker balancer.Picker
+
+	if config.TrafficSplit != nil {
+		picker = newSplitPicker(pickerStates, groupRegistered, config)
+	}
+
+	if picker == nil {
+		picker = newPriorityPicker(pickerStates, registered, config)
+	}
+
+	if config.Affinity {
+		return newAffinityPicker(picker, pickerStates, excluded)
+	}
+
+	return picker
+}
+
+// newPriorityPicker returns a picker for servers ordered by priority,
+// limited by the maximum number of active servers or split into failover tiers.
+func newPriorityPicker(pickerStates []*state, registered map[int]int, config *config.Client) balancer.Picker {
 	slices.SortFunc(pickerStates, func(a, b *state) int {
-		return cmp.Compare(b.priority, a.priority)
+		return cmp.Or(cmp.Compare(b.priority, a.priority), cmp.Compare(b.rank, a.rank))
 	})
 
+	ready := make([]*endpointPicker, len(pickerStates))
+	for i, pickerState := range pickerStates {
+		ready[i] = pickerState.picker
+	}
+
+	if config.PriorityFailover != nil {
+		return newFailoverPicker(pickerStates, registered, config, ready)
+	}
+
 	if config.MaxActiveServers > 0 && config.MaxActiveServers < len(pickerStates) {
 		pickerStates = pickerStates[:config.MaxActiveServers]
 	}
 
+	picker := newPicker(pickerStates)
+	picker.ready = ready
+
+	return picker
+}
+
+func newPicker(pickerStates []*state) *picker {
+	var totalWeight, divisor int
+
+	for _, pickerState := range pickerStates {
+		divisor = gcd(divisor, pickerState.weight)
+	}
+
 	for _, pickerState := range pickerStates {
+		pickerState.weight /= divisor
 		pickerState.factor = int(math.Ceil(float64(pickerState.weight) / float64(len(pickerStates))))
 		totalWeight += pickerState.weight
 	}
 
 	picker := &picker{
-		pickers: make([]balancer.Picker, 0, totalWeight),
+		pickers: make([]*endpointPicker, 0, totalWeight),
 	}
 
 	for {
@@ -113,8 +223,17 @@ func New(childStates []endpointsharding.ChildState, config *config.Client) balan
 	return picker
 }
 
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
+
 type picker struct {
-	pickers []balancer.Picker
+	pickers []*endpointPicker
+	ready   []*endpointPicker
 	mu      sync.Mutex
 	next    int
 }
@@ -17,14 +17,20 @@
 package picker
 
 import (
+	"github.com/nexcode/rpcplatform/internal/admission"
+	"github.com/nexcode/rpcplatform/internal/retry"
+	"github.com/nexcode/rpcplatform/internal/routing"
 	"google.golang.org/grpc/balancer"
 )
 
 func (p *picker) Pick(pickInfo balancer.PickInfo) (balancer.PickResult, error) {
-	p.mu.Lock()
-	picker := p.pickers[p.next]
-	p.next = (p.next + 1) % len(p.pickers)
-	p.mu.Unlock()
+	hints, tracker := routing.FromContext(pickInfo.Ctx), retry.FromContext(pickInfo.Ctx)
 
-	return picker.Pick(pickInfo)
+	if hints == nil && !tracker.Attempted() {
+		if picker := p.nextPicker(); picker.available(admission.FromOutgoingContext(pickInfo.Ctx)) {
+			return picker.Pick(pickInfo)
+		}
+	}
+
+	return pickMatching(pickInfo, hints, tracker, []*picker{p}, p.ready)
 }
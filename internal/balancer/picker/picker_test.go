@@ -17,16 +17,28 @@
 package picker
 
 import (
+	"context"
+	"maps"
 	"slices"
+	"strconv"
 	"testing"
+	"time"
 
+	v3orcapb "github.com/cncf/xds/go/xds/data/orca/v3"
+	"github.com/nexcode/rpcplatform/internal/admission"
+	"github.com/nexcode/rpcplatform/internal/affinity"
 	"github.com/nexcode/rpcplatform/internal/attributes"
 	"github.com/nexcode/rpcplatform/internal/config"
 	"github.com/nexcode/rpcplatform/internal/grpcattrs"
+	"github.com/nexcode/rpcplatform/internal/retry"
+	"github.com/nexcode/rpcplatform/internal/routing"
 	"google.golang.org/grpc/balancer"
 	"google.golang.org/grpc/balancer/endpointsharding"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/status"
 )
 
 func TestPicker(t *testing.T) {
@@ -104,12 +116,12 @@ func TestPicker(t *testing.T) {
 		MaxActiveServers: 3,
 	}
 
-	picker := New(childStates, config).(*picker)
+	picker := New(childStates, config, NewEndpoints(nil)).(*picker)
 	actualSequence := make([]int, len(picker.pickers))
 	pickerNext := picker.next
 
 	for i, childPicker := range picker.pickers {
-		actualSequence[i] = childPicker.(*namedPicker).name
+		actualSequence[i] = pickerName(childPicker)
 
 		if _, err := picker.Pick(balancer.PickInfo{}); err != nil {
 			t.Fatalf("Pick() failed: %v", err)
@@ -132,10 +144,952 @@ func TestPicker(t *testing.T) {
 	}
 }
 
+func TestFailoverPicker(t *testing.T) {
+	t.Parallel()
+
+	type expected struct {
+		names []int
+		loads []float64
+	}
+
+	tests := []struct {
+		name        string
+		childStates []endpointsharding.ChildState
+		config      *config.Client
+		expected    expected
+	}{
+		{
+			"Healthy primary tier receives all requests",
+			[]endpointsharding.ChildState{
+				newChildState(1, 2, connectivity.Ready),
+				newChildState(2, 2, connectivity.Ready),
+				newChildState(3, 2, connectivity.TransientFailure),
+				newChildState(4, 1, connectivity.Ready),
+			},
+			&config.Client{PriorityFailover: &config.PriorityFailover{MinHealthyPercent: 50}},
+			expected{names: []int{1, 2}},
+		}, {
+			"Degraded primary tier overflows to backup tier",
+			[]endpointsharding.ChildState{
+				newChildState(1, 2, connectivity.Ready),
+				newChildState(2, 2, connectivity.TransientFailure),
+				newChildState(3, 2, connectivity.TransientFailure),
+				newChildState(4, 2, connectivity.TransientFailure),
+				newChildState(5, 1, connectivity.Ready),
+			},
+			&config.Client{PriorityFailover: &config.PriorityFailover{MinHealthyPercent: 50}},
+			expected{names: []int{1, 5}, loads: []float64{0.5, 1}},
+		}, {
+			"Minimum healthy count is satisfied",
+			[]endpointsharding.ChildState{
+				newChildState(1, 2, connectivity.Ready),
+				newChildState(2, 2, connectivity.TransientFailure),
+				newChildState(3, 2, connectivity.TransientFailure),
+				newChildState(4, 1, connectivity.Ready),
+			},
+			&config.Client{PriorityFailover: &config.PriorityFailover{MinHealthyPercent: 100, MinHealthyCount: 1}},
+			expected{names: []int{1}},
+		}, {
+			"All tiers are degraded",
+			[]endpointsharding.ChildState{
+				newChildState(1, 3, connectivity.Ready),
+				newChildState(2, 3, connectivity.TransientFailure),
+				newChildState(3, 2, connectivity.Ready),
+				newChildState(4, 2, connectivity.TransientFailure),
+				newChildState(5, 2, connectivity.TransientFailure),
+				newChildState(6, 2, connectivity.TransientFailure),
+			},
+			&config.Client{PriorityFailover: &config.PriorityFailover{MinHealthyCount: 4}},
+			expected{names: []int{1, 3}, loads: []float64{0.5, 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var names []int
+			var loads []float64
+
+			switch p := New(tt.childStates, tt.config, NewEndpoints(nil)).(type) {
+			case *picker:
+				names = pickerNames(p)
+			case *failoverPicker:
+				for _, tierPicker := range p.pickers {
+					names = append(names, pickerNames(tierPicker)...)
+				}
+
+				loads = p.loads
+			default:
+				t.Fatalf("New() returned unexpected picker type %T", p)
+			}
+
+			if !slices.Equal(names, tt.expected.names) {
+				t.Errorf("picker names = %v, want: %v", names, tt.expected.names)
+			}
+
+			if !slices.Equal(loads, tt.expected.loads) {
+				t.Errorf("picker loads = %v, want: %v", loads, tt.expected.loads)
+			}
+		})
+	}
+}
+
+func TestSlowStart(t *testing.T) {
+	t.Parallel()
+
+	childStates := []endpointsharding.ChildState{
+		newChildState(1, 1, connectivity.Ready),
+		newChildState(2, 1, connectivity.Ready),
+	}
+
+	config := &config.Client{
+		SlowStart: &config.SlowStart{Window: time.Hour, Aggression: 1},
+	}
+
+	now := time.Now()
+	endpoints := NewEndpoints(nil)
+	endpoints.Update(childStates[:1], config, now.Add(-2*time.Hour))
+	endpoints.Update(childStates, config, now.Add(-30*time.Minute))
+
+	if next := endpoints.NextUpdate(config, now); next <= 0 {
+		t.Errorf("NextUpdate() = %v, want a positive duration", next)
+	}
+
+	names := New(childStates, config, endpoints).(*picker).pickers
+	expectedNames := []int{1, 2, 1}
+
+	if len(names) != len(expectedNames) {
+		t.Fatalf("picker length = %v, want: %v", len(names), len(expectedNames))
+	}
+
+	for i, name := range names {
+		if pickerName(name) != expectedNames[i] {
+			t.Errorf("picker sequence[%v] = %v, want: %v", i, pickerName(name), expectedNames[i])
+		}
+	}
+
+	if next := endpoints.NextUpdate(config, now.Add(time.Hour)); next != 0 {
+		t.Errorf("NextUpdate() after window = %v, want: 0", next)
+	}
+}
+
+func TestWeightResolution(t *testing.T) {
+	t.Parallel()
+
+	var childStates []endpointsharding.ChildState
+	for name := range 50 {
+		childStates = append(childStates, newChildState(name, 1, connectivity.Ready))
+	}
+
+	config := &config.Client{
+		SlowStart: &config.SlowStart{Window: time.Hour, Aggression: 1},
+	}
+
+	now := time.Now()
+	endpoints := NewEndpoints(nil)
+	endpoints.Update(childStates[1:], config, now.Add(-2*time.Hour))
+	endpoints.Update(childStates, config, now.Add(-20*time.Minute))
+
+	counts := make(map[int]int)
+	for _, childPicker := range New(childStates, config, endpoints).(*picker).pickers {
+		counts[pickerName(childPicker)]++
+	}
+
+	if total := counts[0] + counts[1]*(len(childStates)-1); total > maxTotalWeight+len(childStates) {
+		t.Errorf("picker length = %v, want at most %v", total, maxTotalWeight+len(childStates))
+	}
+
+	// A third of the weight at the resolution of 20 per server.
+	if counts[0] != 7 || counts[1] != 20 {
+		t.Errorf("picker weights of servers 0 and 1 = %v and %v, want: 7 and 20", counts[0], counts[1])
+	}
+}
+
+func TestOutlierDetection(t *testing.T) {
+	t.Parallel()
+
+	childStates := []endpointsharding.ChildState{
+		newChildState(1, 1, connectivity.Ready),
+		newChildState(2, 1, connectivity.Ready),
+		newChildState(3, 1, connectivity.Ready),
+	}
+
+	outlierDetection := &config.OutlierDetection{
+		Interval:           time.Hour,
+		ConsecutiveErrors:  2,
+		MinSuccessRate:     0.5,
+		MinRequests:        2,
+		BaseEjectionTime:   time.Minute,
+		MaxEjectionTime:    time.Hour,
+		MaxEjectionPercent: 30,
+		ErrorCodes:         []codes.Code{codes.Unavailable},
+	}
+
+	config := &config.Client{
+		OutlierDetection: outlierDetection,
+	}
+
+	changed := make(chan struct{}, 1)
+	endpoints := NewEndpoints(func() { changed <- struct{}{} })
+	endpoints.Update(childStates, config, time.Now())
+
+	pick := func(p balancer.Picker, name int, err error) {
+		for _, childPicker := range p.(*picker).pickers {
+			if pickerName(childPicker) != name {
+				continue
+			}
+
+			result, pickErr := childPicker.Pick(balancer.PickInfo{})
+			if pickErr != nil {
+				t.Fatalf("Pick() failed: %v", pickErr)
+			}
+
+			result.Done(balancer.DoneInfo{Err: err, BytesSent: true})
+			return
+		}
+
+		t.Fatalf("server %v is not in the picker", name)
+	}
+
+	p := New(childStates, config, endpoints)
+	pick(p, 1, status.Error(codes.Unavailable, ""))
+	pick(p, 1, status.Error(codes.NotFound, ""))
+	pick(p, 1, status.Error(codes.Unavailable, ""))
+	pick(p, 2, status.Error(codes.Unavailable, ""))
+	pick(p, 1, status.Error(codes.Unavailable, ""))
+
+	select {
+	case <-changed:
+	case <-time.After(time.Second):
+		t.Fatal("picker rebuild was not requested after ejection")
+	}
+
+	p = New(childStates, config, endpoints)
+	if names := pickerNames(p.(*picker)); !slices.Equal(names, []int{2, 3}) {
+		t.Errorf("picker names after ejection = %v, want: %v", names, []int{2, 3})
+	}
+
+	pick(p, 2, status.Error(codes.NotFound, ""))
+	pick(p, 2, status.Error(codes.NotFound, ""))
+	pick(p, 2, status.Error(codes.NotFound, ""))
+	pick(p, 2, status.Error(codes.Unavailable, ""))
+	pick(p, 2, status.Error(codes.Unavailable, ""))
+
+	p = New(childStates, config, endpoints)
+	if names := pickerNames(p.(*picker)); !slices.Equal(names, []int{2, 3}) {
+		t.Errorf("picker names over max ejection percent = %v, want: %v", names, []int{2, 3})
+	}
+
+	if next := endpoints.NextUpdate(config, time.Now()); next <= 0 || next > time.Minute {
+		t.Errorf("NextUpdate() = %v, want a duration up to the ejection time", next)
+	}
+
+	// Server 1 returns after the ejection time and is ejected again by success rate for twice as long.
+	now := time.Now().Add(2 * time.Hour)
+	endpoints.Update(childStates, config, now)
+
+	if ejectedUntil := endpoints.endpoints["1"].outlier.ejectedUntil; !ejectedUntil.Equal(now.Add(2 * time.Minute)) {
+		t.Errorf("server 1 ejected until %v, want: %v", ejectedUntil, now.Add(2*time.Minute))
+	}
+
+	if endpoints.endpoints["2"].outlier.ejected(now) {
+		t.Error("server 2 is ejected with success rate at the threshold")
+	}
+}
+
+func TestOutlierDetection_MaxEjectionPercent(t *testing.T) {
+	t.Parallel()
+
+	childStates := []endpointsharding.ChildState{
+		newChildState(1, 1, connectivity.Ready),
+		newChildState(2, 1, connectivity.Ready),
+		newChildState(3, 1, connectivity.Ready),
+	}
+
+	tests := []struct {
+		name               string
+		maxEjectionPercent int
+		ejected            int
+		expected           bool
+	}{
+		{"Zero", 0, 0, false},
+		{"Default", config.NewOutlierDetection().MaxEjectionPercent, 0, true},
+		{"Default with an ejected server", config.NewOutlierDetection().MaxEjectionPercent, 1, false},
+		{"Below the limit", 50, 1, true},
+		{"At the limit", 50, 2, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			outlierDetection := config.NewOutlierDetection()
+			outlierDetection.MaxEjectionPercent = tt.maxEjectionPercent
+
+			config := &config.Client{
+				OutlierDetection: outlierDetection,
+			}
+
+			now := time.Now()
+			endpoints := NewEndpoints(func() {})
+			endpoints.Update(childStates, config, now)
+
+			endpoints.mu.Lock()
+			defer endpoints.mu.Unlock()
+
+			for i := range tt.ejected {
+				endpoints.endpoints[strconv.Itoa(i+2)].outlier.ejectedUntil = now.Add(time.Hour)
+			}
+
+			if ejected := endpoints.eject(endpoints.endpoints["1"], config.OutlierDetection, now); ejected != tt.expected {
+				t.Errorf("eject() = %v, want: %v", ejected, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	childStates := []endpointsharding.ChildState{
+		newChildState(1, 1, connectivity.Ready),
+		newChildState(2, 1, connectivity.Ready),
+	}
+
+	config := &config.Client{
+		CircuitBreaker: &config.CircuitBreaker{
+			Interval:              time.Hour,
+			ConsecutiveFailures:   2,
+			MaxConcurrentRequests: 2,
+			OpenTime:              time.Minute,
+			HalfOpenRequests:      1,
+			ErrorCodes:            []codes.Code{codes.Unavailable},
+		},
+	}
+
+	changed := make(chan struct{}, 1)
+	endpoints := NewEndpoints(func() { changed <- struct{}{} })
+	endpoints.Update(childStates, config, time.Now())
+
+	pick := func(p balancer.Picker, name string) (balancer.PickResult, error) {
+		return p.Pick(balancer.PickInfo{Ctx: routing.WithServerID(context.Background(), name)})
+	}
+
+	checkState := func(name string, expected CircuitState) {
+		if state := endpoints.Stats()[name].CircuitState; state != expected {
+			t.Errorf("server %v circuit state = %v, want: %v", name, state, expected)
+		}
+	}
+
+	p := New(childStates, config, endpoints)
+
+	for _, err := range []error{status.Error(codes.Unavailable, ""), status.Error(codes.NotFound, ""),
+		status.Error(codes.Unavailable, ""), status.Error(codes.Unavailable, "")} {
+		result, pickErr := pick(p, "1")
+		if pickErr != nil {
+			t.Fatalf("Pick() failed: %v", pickErr)
+		}
+
+		result.Done(balancer.DoneInfo{Err: err, BytesSent: true})
+	}
+
+	select {
+	case <-changed:
+	case <-time.After(time.Second):
+		t.Fatal("picker rebuild was not requested after the breaker opened")
+	}
+
+	checkState("1", CircuitOpen)
+
+	p = New(childStates, config, endpoints)
+	if names := pickerNames(p.(*picker)); !slices.Equal(names, []int{2}) {
+		t.Errorf("picker names with an open breaker = %v, want: %v", names, []int{2})
+	}
+
+	if next := endpoints.NextUpdate(config, time.Now()); next <= 0 || next > time.Minute {
+		t.Errorf("NextUpdate() = %v, want a duration up to the open time", next)
+	}
+
+	// Server 2 accepts no more than 2 concurrent calls.
+	var active []balancer.PickResult
+
+	for range 2 {
+		result, err := p.Pick(balancer.PickInfo{Ctx: context.Background()})
+		if err != nil {
+			t.Fatalf("Pick() failed: %v", err)
+		}
+
+		active = append(active, result)
+	}
+
+	if stats := endpoints.Stats()["2"]; stats.ActiveRequests != 2 {
+		t.Errorf("server 2 active requests = %v, want: 2", stats.ActiveRequests)
+	}
+
+	if _, err := p.Pick(balancer.PickInfo{Ctx: context.Background()}); err != errServersUnavailable {
+		t.Errorf("Pick() over the concurrency limit error = %v, want: %v", err, errServersUnavailable)
+	}
+
+	active[0].Done(balancer.DoneInfo{})
+
+	select {
+	case <-changed:
+	case <-time.After(time.Second):
+		t.Fatal("picker rebuild was not requested after a call at the concurrency limit completed")
+	}
+
+	if _, err := p.Pick(balancer.PickInfo{Ctx: context.Background()}); err != nil {
+		t.Errorf("Pick() after a call completed failed: %v", err)
+	}
+
+	// Server 1 becomes half-open after the open time and lets a single probe through.
+	endpoints.endpoints["1"].breaker.openUntil = time.Now().Add(-time.Second)
+	checkState("1", CircuitHalfOpen)
+
+	p = New(childStates, config, endpoints)
+
+	probe, err := pick(p, "1")
+	if err != nil {
+		t.Fatalf("Pick() of a probe failed: %v", err)
+	}
+
+	if _, err = pick(p, "1"); err != errServersUnavailable {
+		t.Errorf("Pick() of a second probe error = %v, want: %v", err, errServersUnavailable)
+	}
+
+	probe.Done(balancer.DoneInfo{BytesSent: true})
+	checkState("1", CircuitClosed)
+}
+
+func TestPushback(t *testing.T) {
+	t.Parallel()
+
+	childStates := []endpointsharding.ChildState{
+		newChildState(1, 1, connectivity.Ready),
+		newChildState(2, 1, connectivity.Ready),
+	}
+
+	endpoints := NewEndpoints(nil)
+	endpoints.Update(childStates, &config.Client{}, time.Now())
+	p := New(childStates, &config.Client{}, endpoints)
+
+	result, err := p.Pick(balancer.PickInfo{Ctx: routing.WithServerID(context.Background(), "1")})
+	if err != nil {
+		t.Fatalf("Pick() failed: %v", err)
+	}
+
+	result.Done(balancer.DoneInfo{
+		Err:     status.Error(codes.Unavailable, ""),
+		Trailer: metadata.Pairs(admission.PushbackKey, "60000"),
+	})
+
+	if !endpoints.Stats()["1"].PushedBack {
+		t.Error("server 1 is not pushed back")
+	}
+
+	if next := endpoints.NextUpdate(&config.Client{}, time.Now()); next <= 0 || next > time.Minute {
+		t.Errorf("NextUpdate() = %v, want a duration up to the pushback delay", next)
+	}
+
+	for range 10 {
+		result, err = p.Pick(balancer.PickInfo{Ctx: context.Background()})
+		if err != nil {
+			t.Fatalf("Pick() failed: %v", err)
+		}
+
+		if name := result.Metadata.Get("name")[0]; name != "2" {
+			t.Errorf("picked server = %v, want: 2", name)
+		}
+	}
+
+	if _, err = p.Pick(balancer.PickInfo{Ctx: routing.WithServerID(context.Background(), "1")}); err != errServersUnavailable {
+		t.Errorf("Pick() of a pushed back server error = %v, want: %v", err, errServersUnavailable)
+	}
+
+	sheddable := metadata.AppendToOutgoingContext(context.Background(), admission.MetadataKey, admission.CriticalitySheddable.String())
+	if _, err = p.Pick(balancer.PickInfo{Ctx: routing.WithServerID(sheddable, "1")}); err != errServersUnavailable {
+		t.Errorf("Pick() of a pushed back server with a sheddable call error = %v, want: %v", err, errServersUnavailable)
+	}
+
+	critical := metadata.AppendToOutgoingContext(context.Background(), admission.MetadataKey, admission.CriticalityCritical.String())
+	if _, err = p.Pick(balancer.PickInfo{Ctx: routing.WithServerID(critical, "1")}); err != nil {
+		t.Errorf("Pick() of a pushed back server with a critical call failed: %v", err)
+	}
+}
+
+func TestLatencyWeighting(t *testing.T) {
+	t.Parallel()
+
+	childStates := []endpointsharding.ChildState{
+		newChildState(1, 1, connectivity.Ready),
+		newChildState(2, 1, connectivity.Ready),
+		newChildState(3, 1, connectivity.Ready),
+	}
+
+	config := &config.Client{
+		LatencyWeighting: time.Minute,
+	}
+
+	now := time.Now()
+	endpoints := NewEndpoints(nil)
+	endpoints.Update(childStates, config, now)
+
+	endpoints.record(endpoints.endpoints["1"], config, 10*time.Millisecond, balancer.DoneInfo{BytesSent: true})
+	endpoints.record(endpoints.endpoints["2"], config, 40*time.Millisecond, balancer.DoneInfo{BytesSent: true})
+	endpoints.record(endpoints.endpoints["3"], config, time.Millisecond, balancer.DoneInfo{
+		Err:           status.Error(codes.Unavailable, ""),
+		BytesReceived: true,
+	})
+
+	// Picks abandoned because the transport was not ready do not count as fast calls.
+	endpoints.record(endpoints.endpoints["2"], config, time.Microsecond, balancer.DoneInfo{})
+
+	counts := make(map[int]int)
+	for _, childPicker := range New(childStates, config, endpoints).(*picker).pickers {
+		counts[pickerName(childPicker)]++
+	}
+
+	if counts[1] != 4 || counts[2] != 1 || counts[3] != 4 {
+		t.Errorf("picker weights = %v, want: map[1:4 2:1 3:4]", counts)
+	}
+
+	if next := endpoints.NextUpdate(config, now); next != latencyInterval {
+		t.Errorf("NextUpdate() = %v, want: %v", next, latencyInterval)
+	}
+}
+
+func TestLoadReporting(t *testing.T) {
+	t.Parallel()
+
+	childStates := []endpointsharding.ChildState{
+		newChildState(1, 1, connectivity.Ready),
+		newChildState(2, 1, connectivity.Ready),
+		newChildState(3, 1, connectivity.Ready),
+	}
+
+	config := &config.Client{
+		LoadReporting: &config.LoadReporting{},
+	}
+
+	endpoints := NewEndpoints(nil)
+	endpoints.Update(childStates, config, time.Now())
+
+	endpoints.Report("1", &v3orcapb.OrcaLoadReport{RpsFractional: 100, CpuUtilization: 0.5})
+	endpoints.record(endpoints.endpoints["2"], config, time.Millisecond, balancer.DoneInfo{
+		BytesReceived: true,
+		ServerLoad:    &v3orcapb.OrcaLoadReport{RpsFractional: 100, ApplicationUtilization: 1, CpuUtilization: 0.1},
+	})
+
+	counts := make(map[int]int)
+	for _, childPicker := range New(childStates, config, endpoints).(*picker).pickers {
+		counts[pickerName(childPicker)]++
+	}
+
+	if counts[1] != 4 || counts[2] != 2 || counts[3] != 3 {
+		t.Errorf("picker weights = %v, want: map[1:4 2:2 3:3]", counts)
+	}
+}
+
+func TestSubsetting(t *testing.T) {
+	t.Parallel()
+
+	var childStates []endpointsharding.ChildState
+	for name := 1; name <= 10; name++ {
+		childStates = append(childStates, newChildState(name, 1, connectivity.Ready))
+	}
+
+	subset := func(shardKey string, childStates []endpointsharding.ChildState) []int {
+		config := &config.Client{
+			MaxActiveServers: 3,
+			Subsetting:       &config.Subsetting{ShardKey: shardKey},
+		}
+
+		return pickerNames(New(childStates, config, NewEndpoints(nil)).(*picker))
+	}
+
+	selected := make(map[int]int)
+	for i := range 1000 {
+		for _, name := range subset(strconv.Itoa(i), childStates) {
+			selected[name]++
+		}
+	}
+
+	for name := 1; name <= 10; name++ {
+		if selected[name] < 200 || selected[name] > 400 {
+			t.Errorf("server %v is selected by %v of 1000 clients, want about 300", name, selected[name])
+		}
+	}
+
+	names := subset("shardKey", childStates)
+
+	for _, name := range names {
+		reduced := slices.Delete(slices.Clone(childStates), name-1, name)
+		if common := intersect(names, subset("shardKey", reduced)); len(common) != len(names)-1 {
+			t.Errorf("subset without server %v shares %v servers, want: %v", name, len(common), len(names)-1)
+		}
+	}
+
+	for name := 1; name <= 10; name++ {
+		if slices.Contains(names, name) {
+			continue
+		}
+
+		reduced := slices.Delete(slices.Clone(childStates), name-1, name)
+		if reducedNames := subset("shardKey", reduced); !slices.Equal(names, reducedNames) {
+			t.Errorf("subset without server %v = %v, want: %v", name, reducedNames, names)
+		}
+	}
+}
+
+func intersect(a, b []int) []int {
+	var common []int
+
+	for _, v := range a {
+		if slices.Contains(b, v) {
+			common = append(common, v)
+		}
+	}
+
+	return common
+}
+
+func TestRoutingHints(t *testing.T) {
+	t.Parallel()
+
+	childStates := []endpointsharding.ChildState{
+		newChildState(1, 2, connectivity.Ready),
+		newChildState(2, 2, connectivity.Ready),
+		newChildState(3, 1, connectivity.Ready),
+		newChildState(4, 1, connectivity.TransientFailure),
+	}
+
+	labels := &attributes.Attributes{
+		BalancerWeight: 1,
+		Labels:         map[string]string{"version": "1.5"},
+	}
+
+	childStates[2].Endpoint.Attributes = grpcattrs.SetAttributes(childStates[2].Endpoint.Attributes, labels)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected []int
+	}{
+		{
+			"Server ID of an active server",
+			routing.WithServerID(context.Background(), "2"),
+			[]int{2},
+		}, {
+			"Server ID of a ready server over the limit",
+			routing.WithServerID(context.Background(), "3"),
+			[]int{3},
+		}, {
+			"Server ID of a server that is not ready",
+			routing.WithServerID(context.Background(), "4"),
+			nil,
+		}, {
+			"Attribute match",
+			routing.WithAttributeMatch(context.Background(), "version", "1.5"),
+			[]int{3},
+		}, {
+			"Attribute match with priority floor",
+			routing.WithPriorityFloor(routing.WithAttributeMatch(context.Background(), "version", "1.5"), 2),
+			nil,
+		}, {
+			"Priority floor",
+			routing.WithPriorityFloor(context.Background(), 2),
+			[]int{1, 2},
+		},
+	}
+
+	for _, config := range []*config.Client{
+		{MaxActiveServers: 2},
+		{MaxActiveServers: 2, PriorityFailover: &config.PriorityFailover{}},
+	} {
+		picker := New(childStates, config, NewEndpoints(nil))
+
+		for _, tt := range tests {
+			picked := make(map[int]struct{})
+
+			for range 10 {
+				result, err := picker.Pick(balancer.PickInfo{Ctx: tt.ctx})
+				if err != nil {
+					if tt.expected != nil {
+						t.Errorf("%v: Pick() failed: %v", tt.name, err)
+					}
+
+					break
+				}
+
+				name, _ := strconv.Atoi(result.Metadata.Get("name")[0])
+				picked[name] = struct{}{}
+			}
+
+			if names := slices.Sorted(maps.Keys(picked)); !slices.Equal(names, tt.expected) {
+				t.Errorf("%v: picked servers = %v, want: %v", tt.name, names, tt.expected)
+			}
+		}
+	}
+}
+
+func TestTrafficSplit(t *testing.T) {
+	t.Parallel()
+
+	versions := []string{"1.4", "1.4", "1.5", ""}
+
+	newChildStates := func(ready ...int) []endpointsharding.ChildState {
+		childStates := make([]endpointsharding.ChildState, len(versions))
+
+		for i, version := range versions {
+			connectivityState := connectivity.TransientFailure
+			if slices.Contains(ready, i+1) {
+				connectivityState = connectivity.Ready
+			}
+
+			childStates[i] = newChildState(i+1, 0, connectivityState)
+			childStates[i].Endpoint.Attributes = grpcattrs.SetAttributes(childStates[i].Endpoint.Attributes, &attributes.Attributes{
+				BalancerWeight: 1,
+				Labels:         map[string]string{"version": version},
+			})
+		}
+
+		return childStates
+	}
+
+	config := &config.Client{
+		TrafficSplit: &config.TrafficSplit{
+			Key:     "version",
+			Weights: map[string]int{"1.4": 90, "1.5": 10},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		childStates []endpointsharding.ChildState
+		expected    map[int][2]int
+	}{
+		{
+			"All servers are ready",
+			newChildStates(1, 2, 3, 4),
+			map[int][2]int{1: {400, 500}, 2: {400, 500}, 3: {50, 150}},
+		}, {
+			"Canary servers are not ready",
+			newChildStates(1, 2, 4),
+			map[int][2]int{1: {500, 500}, 2: {500, 500}},
+		}, {
+			"Only unlisted servers are ready",
+			newChildStates(4),
+			map[int][2]int{4: {1000, 1000}},
+		},
+	}
+
+	for _, tt := range tests {
+		picker := New(tt.childStates, config, NewEndpoints(nil))
+		picked := make(map[int]int)
+
+		for range 1000 {
+			result, err := picker.Pick(balancer.PickInfo{Ctx: context.Background()})
+			if err != nil {
+				t.Fatalf("%v: Pick() failed: %v", tt.name, err)
+			}
+
+			name, _ := strconv.Atoi(result.Metadata.Get("name")[0])
+			picked[name]++
+		}
+
+		if names := slices.Sorted(maps.Keys(picked)); !slices.Equal(names, slices.Sorted(maps.Keys(tt.expected))) {
+			t.Errorf("%v: picked servers = %v, want: %v", tt.name, names, slices.Sorted(maps.Keys(tt.expected)))
+		}
+
+		for name, bounds := range tt.expected {
+			if picked[name] < bounds[0] || picked[name] > bounds[1] {
+				t.Errorf("%v: server %v picked %v times, want: %v-%v", tt.name, name, picked[name], bounds[0], bounds[1])
+			}
+		}
+
+		// Unlisted servers are still picked by calls with matching routing hints.
+		result, err := picker.Pick(balancer.PickInfo{Ctx: routing.WithServerID(context.Background(), "4")})
+		if err != nil {
+			t.Fatalf("%v: Pick() of an unlisted server failed: %v", tt.name, err)
+		}
+
+		if name := result.Metadata.Get("name")[0]; name != "4" {
+			t.Errorf("%v: picked server = %v, want: 4", tt.name, name)
+		}
+	}
+}
+
+func TestAffinity(t *testing.T) {
+	t.Parallel()
+
+	childStates := []endpointsharding.ChildState{
+		newChildState(1, 1, connectivity.Ready),
+		newChildState(2, 1, connectivity.Ready),
+		newChildState(3, 0, connectivity.Ready),
+		newChildState(4, 1, connectivity.TransientFailure),
+	}
+
+	withToken := func(id string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), affinity.MetadataKey, affinity.Token(id))
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected []int
+	}{
+		{
+			"No token",
+			context.Background(),
+			[]int{1, 2},
+		}, {
+			"Token of an active server",
+			withToken("2"),
+			[]int{2},
+		}, {
+			"Token of a ready server over the limit",
+			withToken("3"),
+			[]int{3},
+		}, {
+			"Token of a server that is not ready",
+			withToken("4"),
+			[]int{1, 2},
+		}, {
+			"Token of an unknown server",
+			withToken("5"),
+			[]int{1, 2},
+		}, {
+			"Invalid token",
+			metadata.AppendToOutgoingContext(context.Background(), affinity.MetadataKey, "!"),
+			[]int{1, 2},
+		}, {
+			"Token of a server that does not match hints",
+			routing.WithPriorityFloor(withToken("3"), 1),
+			[]int{1, 2},
+		},
+	}
+
+	picker := New(childStates, &config.Client{MaxActiveServers: 2, Affinity: true}, NewEndpoints(nil))
+
+	for _, tt := range tests {
+		picked := make(map[int]struct{})
+
+		for range 10 {
+			result, err := picker.Pick(balancer.PickInfo{Ctx: tt.ctx})
+			if err != nil {
+				t.Fatalf("%v: Pick() failed: %v", tt.name, err)
+			}
+
+			name, _ := strconv.Atoi(result.Metadata.Get("name")[0])
+			picked[name] = struct{}{}
+		}
+
+		if names := slices.Sorted(maps.Keys(picked)); !slices.Equal(names, tt.expected) {
+			t.Errorf("%v: picked servers = %v, want: %v", tt.name, names, tt.expected)
+		}
+	}
+
+	// Calls with the token of a ready server that is unavailable are not moved to another server.
+	now := time.Now()
+	endpoints := NewEndpoints(nil)
+	endpoints.Update(childStates, &config.Client{}, now)
+	endpoints.endpoints["1"].outlier.ejectedUntil = now.Add(time.Hour)
+	endpoints.endpoints["2"].pushbackUntil[admission.CriticalityDefault-admission.CriticalitySheddable].Store(now.Add(time.Hour).UnixNano())
+
+	picker = New(childStates, &config.Client{Affinity: true}, endpoints)
+
+	for _, id := range []string{"1", "2"} {
+		if _, err := picker.Pick(balancer.PickInfo{Ctx: withToken(id)}); err != errAffinityServerUnavailable {
+			t.Errorf("Pick() with the token of unavailable server %v error = %v, want: %v", id, err, errAffinityServerUnavailable)
+		}
+	}
+}
+
+func TestRetries(t *testing.T) {
+	t.Parallel()
+
+	childStates := []endpointsharding.ChildState{
+		newChildState(1, 1, connectivity.Ready),
+		newChildState(2, 1, connectivity.Ready),
+		newChildState(3, 0, connectivity.Ready),
+	}
+
+	for _, config := range []*config.Client{
+		{},
+		{MaxActiveServers: 1},
+		{PriorityFailover: &config.PriorityFailover{}},
+		{Affinity: true},
+	} {
+		picker := New(childStates, config, NewEndpoints(nil))
+
+		for range 10 {
+			ctx := retry.NewContext(context.Background())
+			ctx = metadata.AppendToOutgoingContext(ctx, affinity.MetadataKey, affinity.Token("1"))
+
+			// A pick abandoned because the transport is not ready does not count as a tried server.
+			abandoned, err := picker.Pick(balancer.PickInfo{Ctx: ctx})
+			if err != nil {
+				t.Fatalf("%+v: Pick() failed: %v", config, err)
+			}
+
+			abandoned.Done(balancer.DoneInfo{})
+
+			var names []int
+
+			for range len(childStates) + 1 {
+				result, err := picker.Pick(balancer.PickInfo{Ctx: ctx})
+				if err != nil {
+					t.Fatalf("%+v: Pick() failed: %v", config, err)
+				}
+
+				result.Done(balancer.DoneInfo{BytesSent: true})
+
+				name, _ := strconv.Atoi(result.Metadata.Get("name")[0])
+				names = append(names, name)
+			}
+
+			if tried := slices.Sorted(slices.Values(names[:len(childStates)])); !slices.Equal(tried, []int{1, 2, 3}) {
+				t.Errorf("%+v: picked servers = %v, want each server once", config, names)
+			}
+		}
+	}
+}
+
+func newChildState(name, priority int, connectivityState connectivity.State) endpointsharding.ChildState {
+	return endpointsharding.ChildState{
+		State: balancer.State{
+			ConnectivityState: connectivityState,
+			Picker:            &namedPicker{name: name},
+		},
+		Endpoint: resolver.Endpoint{
+			Attributes: grpcattrs.SetServerID(grpcattrs.SetAttributes(nil, &attributes.Attributes{
+				BalancerWeight:   1,
+				BalancerPriority: priority,
+			}), strconv.Itoa(name)),
+		},
+	}
+}
+
+func pickerNames(p *picker) []int {
+	var names []int
+
+	for _, childPicker := range p.pickers {
+		names = append(names, pickerName(childPicker))
+	}
+
+	slices.Sort(names)
+	return names
+}
+
+func pickerName(p *endpointPicker) int {
+	return p.Picker.(*namedPicker).name
+}
+
 type namedPicker struct {
 	name int
 }
 
-func (namedPicker) Pick(balancer.PickInfo) (balancer.PickResult, error) {
-	return balancer.PickResult{}, nil
+func (p namedPicker) Pick(balancer.PickInfo) (balancer.PickResult, error) {
+	return balancer.PickResult{Metadata: metadata.Pairs("name", strconv.Itoa(p.name))}, nil
 }
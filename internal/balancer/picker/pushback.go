@@ -0,0 +1,54 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/nexcode/rpcplatform/internal/admission"
+	"google.golang.org/grpc/metadata"
+)
+
+// pushBack makes the server unavailable to calls with the criticality of the shed call
+// for the delay that it requested in the trailer of the call. It reports whether a new pushback started.
+func (e *endpoint) pushBack(criticality admission.Criticality, trailer metadata.MD, now time.Time) bool {
+	values := trailer.Get(admission.PushbackKey)
+	if len(values) == 0 {
+		return false
+	}
+
+	delay, err := strconv.ParseInt(values[len(values)-1], 10, 64)
+	if err != nil || delay <= 0 {
+		return false
+	}
+
+	until := now.Add(time.Duration(delay) * time.Millisecond).UnixNano()
+	return e.pushbackUntil[criticality-admission.CriticalitySheddable].Swap(until) <= now.UnixNano()
+}
+
+// pushedBack reports whether the server asked clients not to send calls with the criticality at the given time.
+// Calls with lower criticality than a shed call are avoided as well, since the server sheds them first.
+func (e *endpoint) pushedBack(criticality admission.Criticality, now time.Time) bool {
+	for i := int(criticality - admission.CriticalitySheddable); i < len(e.pushbackUntil); i++ {
+		if e.pushbackUntil[i].Load() > now.UnixNano() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"math"
+	"time"
+
+	"github.com/nexcode/rpcplatform/internal/config"
+)
+
+// slowStartFactor returns the multiplier in the range [slowStartMinFactor, 1]
+// applied to the weight of a server that became ready at readySince.
+func slowStartFactor(slowStart *config.SlowStart, readySince, now time.Time) float64 {
+	if slowStart == nil || slowStart.Window <= 0 || readySince.IsZero() {
+		return 1
+	}
+
+	elapsed := now.Sub(readySince)
+	if elapsed >= slowStart.Window {
+		return 1
+	}
+
+	aggression := slowStart.Aggression
+	if aggression <= 0 {
+		aggression = 1
+	}
+
+	factor := math.Pow(float64(max(elapsed, 0))/float64(slowStart.Window), 1/aggression)
+	return max(factor, slowStartMinFactor)
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/nexcode/rpcplatform/internal/attributes"
+	"github.com/nexcode/rpcplatform/internal/config"
+	"google.golang.org/grpc/balancer"
+)
+
+// splitGroup returns the value of the attribute that splits traffic between server groups.
+func splitGroup(trafficSplit *config.TrafficSplit, attrs *attributes.Attributes) string {
+	if trafficSplit == nil {
+		return ""
+	}
+
+	group, _ := attributes.Lookup(attrs, trafficSplit.Key)
+	return group
+}
+
+// newSplitPicker returns a picker that distributes requests between server groups according to their weights.
+// The share of groups without ready servers is distributed among the remaining groups.
+// Servers of groups without a positive weight are picked only by calls with matching routing hints.
+// It returns nil if no group with a positive weight has ready servers.
+func newSplitPicker(pickerStates []*state, registered map[string]map[int]int, config *config.Client) balancer.Picker {
+	groups := make(map[string][]*state)
+	ready := make([]*endpointPicker, len(pickerStates))
+	var grouped int
+
+	for i, pickerState := range pickerStates {
+		ready[i] = pickerState.picker
+
+		if config.TrafficSplit.Weights[pickerState.group] > 0 {
+			groups[pickerState.group] = append(groups[pickerState.group], pickerState)
+			grouped++
+		}
+	}
+
+	if len(groups) == 0 {
+		return nil
+	}
+
+	picker := &splitPicker{ready: ready}
+	var totalWeight int
+
+	for _, group := range slices.Sorted(maps.Keys(groups)) {
+		totalWeight += config.TrafficSplit.Weights[group]
+
+		picker.pickers = append(picker.pickers, newPriorityPicker(groups[group], registered[group], config))
+		picker.loads = append(picker.loads, float64(totalWeight))
+	}
+
+	if len(picker.pickers) == 1 && grouped == len(pickerStates) {
+		return picker.pickers[0]
+	}
+
+	for i := range picker.loads {
+		picker.loads[i] /= float64(totalWeight)
+	}
+
+	return picker
+}
+
+type splitPicker struct {
+	pickers []balancer.Picker
+	loads   []float64
+	ready   []*endpointPicker
+}
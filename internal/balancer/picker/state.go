@@ -16,13 +16,13 @@
 
 package picker
 
-import (
-	"google.golang.org/grpc/balancer"
-)
-
 type state struct {
-	picker   balancer.Picker
+	picker   *endpointPicker
+	id       string
+	group    string
+	endpoint *endpoint
 	priority int
+	rank     uint64
 	weight   int
 	factor   int
 	count    int
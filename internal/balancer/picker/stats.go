@@ -0,0 +1,33 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+// EndpointStats contains the balancing state of a single server.
+type EndpointStats struct {
+	// Ejected reports whether the server is ejected by outlier detection.
+	Ejected bool
+
+	// PushedBack reports whether the server asked the client not to send calls of some criticality for a while.
+	PushedBack bool
+
+	// CircuitState is the state of the circuit breaker of the server.
+	CircuitState CircuitState
+
+	// ActiveRequests is the number of calls in flight to the server.
+	// It is counted only when circuit breakers are enabled.
+	ActiveRequests int
+}
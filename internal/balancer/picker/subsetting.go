@@ -0,0 +1,39 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package picker
+
+import (
+	"hash/fnv"
+)
+
+// subsetRank returns the rendezvous hashing rank of a server for the given shard key.
+// Servers with higher ranks are preferred when the number of active servers is limited,
+// so each shard key selects its own stable subset: adding or removing a server
+// changes at most one member of the subset.
+func subsetRank(shardKey, id string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(shardKey))
+	h.Write([]byte{0})
+	h.Write([]byte(id))
+
+	// Finalize with the splitmix64 mixer to spread similar keys evenly.
+	x := h.Sum64()
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+
+	return x ^ (x >> 31)
+}
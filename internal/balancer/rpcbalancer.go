@@ -17,12 +17,25 @@
 package balancer
 
 import (
+	"sync"
+	"time"
+
+	"github.com/nexcode/rpcplatform/internal/balancer/picker"
 	"github.com/nexcode/rpcplatform/internal/config"
 	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/endpointsharding"
+	"google.golang.org/grpc/connectivity"
 )
 
 type rpcBalancer struct {
 	balancer.Balancer
 	balancer.ClientConn
 	config *config.Client
+
+	mu          sync.Mutex
+	closed      bool
+	timer       *time.Timer
+	endpoints   *picker.Endpoints
+	childStates []endpointsharding.ChildState
+	state       connectivity.State
 }
@@ -0,0 +1,61 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package balancer
+
+import (
+	"github.com/nexcode/rpcplatform/internal/grpcattrs"
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/orca"
+	"google.golang.org/grpc/resolver"
+)
+
+// NewSubConn subscribes to out-of-band load reports of the server while the SubConn is ready.
+func (b *rpcBalancer) NewSubConn(addrs []resolver.Address, opts balancer.NewSubConnOptions) (balancer.SubConn, error) {
+	b.mu.Lock()
+	config, endpoints := b.config, b.endpoints
+	b.mu.Unlock()
+
+	if len(addrs) == 0 || config == nil || config.LoadReporting == nil || config.LoadReporting.Interval <= 0 {
+		return b.ClientConn.NewSubConn(addrs, opts)
+	}
+
+	var subConn balancer.SubConn
+	var stop func()
+
+	listener := &loadListener{
+		endpoints: endpoints,
+		id:        grpcattrs.GetServerID(addrs[0].BalancerAttributes),
+	}
+
+	stateListener := opts.StateListener
+	opts.StateListener = func(state balancer.SubConnState) {
+		if state.ConnectivityState == connectivity.Ready && stop == nil {
+			stop = orca.RegisterOOBListener(subConn, listener, orca.OOBListenerOptions{
+				ReportInterval: config.LoadReporting.Interval,
+			})
+		} else if state.ConnectivityState != connectivity.Ready && stop != nil {
+			stop()
+			stop = nil
+		}
+
+		stateListener(state)
+	}
+
+	subConn, err := b.ClientConn.NewSubConn(addrs, opts)
+	return subConn, err
+}
@@ -23,8 +23,16 @@ import (
 )
 
 func (b *rpcBalancer) UpdateClientConnState(ccs balancer.ClientConnState) error {
+	b.mu.Lock()
 	b.config = grpcattrs.GetClientConfig(ccs.ResolverState.Attributes)
 
+	if endpoints := getEndpoints(ccs.ResolverState.Attributes); endpoints != nil && endpoints != b.endpoints {
+		endpoints.OnChange(b.refresh)
+		b.endpoints = endpoints
+	}
+
+	b.mu.Unlock()
+
 	return b.Balancer.UpdateClientConnState(balancer.ClientConnState{
 		ResolverState: pickfirst.EnableHealthListener(ccs.ResolverState),
 	})
@@ -0,0 +1,58 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package balancer
+
+import (
+	"time"
+
+	"github.com/nexcode/rpcplatform/internal/balancer/picker"
+	"google.golang.org/grpc/balancer"
+)
+
+// refresh rebuilds the picker outside of gRPC state updates.
+func (b *rpcBalancer) refresh() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.updatePicker()
+}
+
+// updatePicker builds a new picker from the latest child states and schedules
+// the next rebuild if effective server weights change over time.
+// It must be called with b.mu held.
+func (b *rpcBalancer) updatePicker() {
+	if b.closed {
+		return
+	}
+
+	now := time.Now()
+	b.endpoints.Update(b.childStates, b.config, now)
+
+	b.ClientConn.UpdateState(balancer.State{
+		ConnectivityState: b.state,
+		Picker:            picker.New(b.childStates, b.config, b.endpoints),
+	})
+
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+
+	if next := b.endpoints.NextUpdate(b.config, now); next > 0 {
+		b.timer = time.AfterFunc(next, b.refresh)
+	}
+}
@@ -17,14 +17,16 @@
 package balancer
 
 import (
-	"github.com/nexcode/rpcplatform/internal/balancer/picker"
 	"google.golang.org/grpc/balancer"
 	"google.golang.org/grpc/balancer/endpointsharding"
 )
 
 func (b *rpcBalancer) UpdateState(state balancer.State) {
-	childStates := endpointsharding.ChildStatesFromPicker(state.Picker)
-	state.Picker = picker.New(childStates, b.config)
+	b.mu.Lock()
+	defer b.mu.Unlock()
 
-	b.ClientConn.UpdateState(state)
+	b.childStates = endpointsharding.ChildStatesFromPicker(state.Picker)
+	b.state = state.ConnectivityState
+
+	b.updatePicker()
 }
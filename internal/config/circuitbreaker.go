@@ -0,0 +1,65 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+func NewCircuitBreaker() *CircuitBreaker {
+	return &CircuitBreaker{
+		Interval:            10 * time.Second,
+		MaxErrorRate:        0.5,
+		MinRequests:         20,
+		ConsecutiveFailures: 5,
+		OpenTime:            30 * time.Second,
+		HalfOpenRequests:    1,
+		ErrorCodes:          []codes.Code{codes.Unavailable, codes.Internal, codes.DeadlineExceeded},
+	}
+}
+
+type CircuitBreaker struct {
+	// Interval is the period over which error rates are calculated.
+	Interval time.Duration
+
+	// MaxErrorRate is the error rate in the range [0, 1] at which the breaker opens.
+	// A value of 0 disables opening by error rate.
+	MaxErrorRate float64
+
+	// MinRequests is the minimum number of calls within an interval required to open the breaker by error rate.
+	MinRequests int
+
+	// ConsecutiveFailures is the number of consecutive failed calls at which the breaker opens.
+	// A value of 0 disables opening by consecutive failures.
+	ConsecutiveFailures int
+
+	// MaxConcurrentRequests is the maximum number of calls in flight to a single server.
+	// A server at the limit is skipped until one of its calls completes. A value of 0 means no limit.
+	MaxConcurrentRequests int
+
+	// OpenTime is the duration an open breaker removes the server from rotation before it becomes half-open.
+	OpenTime time.Duration
+
+	// HalfOpenRequests is the maximum number of probe calls in flight to a half-open server.
+	// A successful probe closes the breaker; a failed probe opens it again.
+	HalfOpenRequests int
+
+	// ErrorCodes are the gRPC status codes counted as failed calls.
+	ErrorCodes []codes.Code
+}
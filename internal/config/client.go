@@ -30,6 +30,46 @@ func NewClient() *Client {
 
 type Client struct {
 	MaxActiveServers  int
+	MaxConcurrency    *MaxConcurrency
+	WaitForServers    *WaitForServers
+	Subsetting        *Subsetting
+	PriorityFailover  *PriorityFailover
+	TrafficSplit      *TrafficSplit
+	Affinity          bool
+	SlowStart         *SlowStart
+	OutlierDetection  *OutlierDetection
+	CircuitBreaker    *CircuitBreaker
+	LatencyWeighting  time.Duration
+	LoadReporting     *LoadReporting
+	MethodConfigs     []*MethodConfig
 	EtcdClientTimeout time.Duration
 	GRPCOptions       []grpc.DialOption
 }
+
+type PriorityFailover struct {
+	MinHealthyPercent int
+	MinHealthyCount   int
+}
+
+type SlowStart struct {
+	Window     time.Duration
+	Aggression float64
+}
+
+type LoadReporting struct {
+	Interval time.Duration
+}
+
+type Subsetting struct {
+	ShardKey string
+}
+
+type MaxConcurrency struct {
+	Requests     int
+	QueueSize    int
+	QueueTimeout time.Duration
+}
+
+type WaitForServers struct {
+	Timeout time.Duration
+}
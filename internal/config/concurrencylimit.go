@@ -0,0 +1,62 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"time"
+)
+
+func NewConcurrencyLimit() *ConcurrencyLimit {
+	return &ConcurrencyLimit{
+		Limit:            100,
+		MinLimit:         10,
+		MaxLimit:         1000,
+		LatencyThreshold: time.Second,
+		BackoffRatio:     0.9,
+		PushbackDelay:    time.Second,
+	}
+}
+
+type ConcurrencyLimit struct {
+	// Limit is the maximum number of calls and streams handled concurrently.
+	// In adaptive mode, it is the initial limit.
+	Limit int
+
+	// Adaptive adjusts the limit with the additive-increase/multiplicative-decrease algorithm:
+	// the limit grows by one after each call completed in time while at least half of the limit is in use,
+	// and is multiplied by BackoffRatio after each call that indicates overload.
+	Adaptive bool
+
+	// MinLimit and MaxLimit bound the limit in adaptive mode.
+	MinLimit int
+	MaxLimit int
+
+	// LatencyThreshold is the call duration above which a call indicates overload in adaptive mode.
+	// Calls that fail with DEADLINE_EXCEEDED or RESOURCE_EXHAUSTED also indicate overload.
+	LatencyThreshold time.Duration
+
+	// BackoffRatio is the factor in the range (0, 1) by which the limit decreases on overload in adaptive mode.
+	BackoffRatio float64
+
+	// PushbackDelay is the delay that shed calls ask clients to wait before sending more calls to the server.
+	PushbackDelay time.Duration
+
+	// Exempt lists methods, such as "/pkg.Service/Method", and services, such as "/pkg.Service/",
+	// whose calls and streams are neither counted against the limit nor shed, such as long-lived watch streams.
+	// The ORCA load reporting service is always exempt.
+	Exempt []string
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+type MethodConfig struct {
+	// Names are the methods the config applies to.
+	// An empty Method applies the config to all methods of the service,
+	// and a name with an empty Service applies it to all methods that no other config matches.
+	Names []MethodName
+
+	// Timeout is the maximum duration of a call, including all retry and hedging attempts.
+	// A value of 0 means no timeout other than the deadline of the call context.
+	Timeout time.Duration
+
+	// RetryPolicy retries calls that fail with retryable status codes.
+	// It cannot be combined with HedgingPolicy.
+	RetryPolicy *RetryPolicy
+
+	// HedgingPolicy sends additional attempts of a call without waiting for a response to the previous one.
+	// It cannot be combined with RetryPolicy.
+	HedgingPolicy *HedgingPolicy
+}
+
+type MethodName struct {
+	Service string
+	Method  string
+}
+
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the original call. It must be greater than 1.
+	// gRPC limits it to 5.
+	MaxAttempts int
+
+	// InitialBackoff is the upper bound of the randomized delay before the first retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff is the maximum upper bound of the randomized delay before a retry.
+	MaxBackoff time.Duration
+
+	// BackoffMultiplier is the factor by which the delay bound grows after each retry.
+	BackoffMultiplier float64
+
+	// RetryableStatusCodes are the gRPC status codes of attempts that are retried.
+	RetryableStatusCodes []codes.Code
+}
+
+type HedgingPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the original call. It must be greater than 1.
+	// gRPC limits it to 5.
+	MaxAttempts int
+
+	// HedgingDelay is the delay between attempts.
+	HedgingDelay time.Duration
+
+	// NonFatalStatusCodes are the gRPC status codes of attempts that do not cancel the remaining attempts.
+	NonFatalStatusCodes []codes.Code
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+func NewOutlierDetection() *OutlierDetection {
+	return &OutlierDetection{
+		Interval:           10 * time.Second,
+		ConsecutiveErrors:  5,
+		MinRequests:        100,
+		BaseEjectionTime:   30 * time.Second,
+		MaxEjectionTime:    5 * time.Minute,
+		MaxEjectionPercent: 10,
+		ErrorCodes:         []codes.Code{codes.Unavailable, codes.Internal},
+	}
+}
+
+type OutlierDetection struct {
+	// Interval is the period over which success rates are calculated.
+	Interval time.Duration
+
+	// ConsecutiveErrors is the number of consecutive failed calls after which a server is ejected.
+	// A value of 0 disables ejection by consecutive errors.
+	ConsecutiveErrors int
+
+	// MinSuccessRate is the success rate in the range [0, 1] below which a server is ejected
+	// at the end of an interval. A value of 0 disables ejection by success rate.
+	MinSuccessRate float64
+
+	// MinRequests is the minimum number of calls within an interval required to eject a server by success rate.
+	MinRequests int
+
+	// BaseEjectionTime is the duration of the first ejection.
+	// Each subsequent ejection of the same server doubles the duration.
+	BaseEjectionTime time.Duration
+
+	// MaxEjectionTime is the maximum duration of a single ejection.
+	MaxEjectionTime time.Duration
+
+	// MaxEjectionPercent is the maximum percentage of servers that can be ejected at the same time.
+	// A server is ejected while the percentage of ejected servers is below it, so the last ejection may exceed it.
+	MaxEjectionPercent int
+
+	// ErrorCodes are the gRPC status codes counted as failed calls.
+	ErrorCodes []codes.Code
+}
@@ -17,17 +17,41 @@
 package config
 
 import (
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 func NewPlatform() *Platform {
-	return &Platform{}
+	return &Platform{
+		Resource: Resource{
+			Timeout: 10 * time.Second,
+		},
+	}
 }
 
 type Platform struct {
-	ClientOptions []func(*Client)
-	ServerOptions []func(*Server)
-	OpenTelemetry *OpenTelemetry
+	ClientOptions  []func(*Client)
+	ServerOptions  []func(*Server)
+	OpenTelemetry  *OpenTelemetry
+	TracerProvider oteltrace.TracerProvider
+	MeterProvider  metric.MeterProvider
+
+	TLS              *TLS
+	TrustDomain      string
+	Resource         Resource
+	Sampler          trace.Sampler
+	Propagator       propagation.TextMapPropagator
+	TelemetryExclude []string
+}
+
+type TLS struct {
+	CertFile string
+	KeyFile  string
+	CAFile   string
 }
 
 type OpenTelemetry struct {
@@ -37,5 +37,9 @@ type Server struct {
 	EtcdClientTimeout time.Duration
 	EtcdLeaseTimeout  time.Duration
 	Attributes        *attributes.Attributes
+	LoadReporting     *LoadReporting
+	Affinity          bool
+	ConcurrencyLimit  *ConcurrencyLimit
+	Authorize         func(peerService, method string) bool
 	GRPCOptions       []grpc.ServerOption
 }
@@ -0,0 +1,27 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+type TrafficSplit struct {
+	// Key is the attribute or label that identifies server groups, for example, "version".
+	Key string `json:"key"`
+
+	// Weights are the relative shares of requests for each attribute value.
+	// Servers with other values do not receive requests
+	// unless none of the listed groups has ready servers.
+	Weights map[string]int `json:"weights"`
+}
@@ -21,4 +21,5 @@ type attrKey uint8
 const (
 	keyAttributes attrKey = iota
 	keyClientConfig
+	keyServerID
 )
@@ -0,0 +1,30 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpcattrs
+
+import (
+	grpcattrs "google.golang.org/grpc/attributes"
+)
+
+func GetServerID(attrs *grpcattrs.Attributes) string {
+	value, _ := attrs.Value(keyServerID).(string)
+	return value
+}
+
+func SetServerID(attrs *grpcattrs.Attributes, value string) *grpcattrs.Attributes {
+	return attrs.WithValue(keyServerID, value)
+}
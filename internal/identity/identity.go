@@ -0,0 +1,66 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package identity
+
+import (
+	"context"
+	"crypto/x509"
+	"net/url"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+const scheme = "spiffe"
+
+// New returns the SPIFFE-style identity of the service, such as "spiffe://example.org/service".
+func New(trustDomain, service string) string {
+	return (&url.URL{Scheme: scheme, Host: trustDomain, Path: "/" + service}).String()
+}
+
+// Service returns the name of the service identified by the URI SANs of the certificate
+// in the trust domain, or an empty string if the certificate has no such identity.
+// No certificate has an identity in the empty trust domain.
+func Service(trustDomain string, certificate *x509.Certificate) string {
+	if trustDomain == "" {
+		return ""
+	}
+
+	for _, uri := range certificate.URIs {
+		if uri.Scheme == scheme && uri.Host == trustDomain {
+			return strings.TrimPrefix(uri.Path, "/")
+		}
+	}
+
+	return ""
+}
+
+// FromContext returns the service name of the verified peer of a server call.
+func FromContext(ctx context.Context, trustDomain string) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 {
+		return ""
+	}
+
+	return Service(trustDomain, tlsInfo.State.VerifiedChains[0][0])
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package identity
+
+import (
+	"crypto/x509"
+	"net/url"
+	"testing"
+)
+
+func TestService(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		trustDomain string
+		uris        []string
+		expected    string
+	}{
+		{"Identity in trust domain", "test", []string{New("test", "service")}, "service"},
+		{"Identity in another trust domain", "test", []string{New("other", "service")}, ""},
+		{"Identity after other URIs", "test", []string{"https://test/other", New("test", "service")}, "service"},
+		{"Identity without trust domain", "", []string{New("", "service")}, ""},
+		{"No URIs", "test", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			certificate := &x509.Certificate{}
+
+			for _, uri := range tt.uris {
+				parsed, err := url.Parse(uri)
+				if err != nil {
+					t.Fatalf("url.Parse() failed: %v", err)
+				}
+
+				certificate.URIs = append(certificate.URIs, parsed)
+			}
+
+			if service := Service(tt.trustDomain, certificate); service != tt.expected {
+				t.Errorf("Service() = %v, want: %v", service, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package identity
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// UnaryServerInterceptor rejects calls of peer services that authorize does not allow to invoke the method.
+func UnaryServerInterceptor(trustDomain string, authorize func(peerService, method string) bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !authorize(FromContext(ctx, trustDomain), info.FullMethod) {
+			return nil, errPermissionDenied
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects streams of peer services that authorize does not allow to invoke the method.
+func StreamServerInterceptor(trustDomain string, authorize func(peerService, method string) bool) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !authorize(FromContext(ss.Context(), trustDomain), info.FullMethod) {
+			return errPermissionDenied
+		}
+
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package limiter
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// UnaryClientInterceptor limits the number of concurrent calls with the limiter.
+func UnaryClientInterceptor(l *Limiter) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := l.Acquire(ctx); err != nil {
+			return err
+		}
+
+		defer l.Release()
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor limits the number of concurrent streams with the limiter.
+// A stream holds its slot until it finishes or its context is done.
+func StreamClientInterceptor(l *Limiter) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if err := l.Acquire(ctx); err != nil {
+			return nil, err
+		}
+
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			l.Release()
+			return nil, err
+		}
+
+		stop := context.AfterFunc(stream.Context(), l.Release)
+
+		return &limitedStream{
+			ClientStream: stream,
+			desc:         desc,
+			release: func() {
+				if stop() {
+					l.Release()
+				}
+			},
+		}, nil
+	}
+}
+
+// limitedStream releases the slot of a stream when it receives the final message or an error.
+type limitedStream struct {
+	grpc.ClientStream
+	desc    *grpc.StreamDesc
+	release func()
+}
+
+func (s *limitedStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+
+	if err != nil || !s.desc.ServerStreams {
+		s.release()
+	}
+
+	return err
+}
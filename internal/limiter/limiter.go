@@ -0,0 +1,38 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package limiter
+
+import (
+	"time"
+)
+
+// New returns a limiter that allows up to limit concurrent calls and queues up to queueSize more calls
+// for at most queueTimeout each. A queueTimeout of 0 means queued calls wait until their context is done.
+func New(limit, queueSize int, queueTimeout time.Duration) *Limiter {
+	return &Limiter{
+		slots:        make(chan struct{}, limit),
+		queue:        make(chan struct{}, queueSize),
+		queueTimeout: queueTimeout,
+	}
+}
+
+// Limiter bounds the number of concurrent calls.
+type Limiter struct {
+	slots        chan struct{}
+	queue        chan struct{}
+	queueTimeout time.Duration
+}
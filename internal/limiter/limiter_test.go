@@ -0,0 +1,79 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package limiter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLimiter(t *testing.T) {
+	t.Parallel()
+
+	l := New(1, 1, 50*time.Millisecond)
+
+	if err := l.Acquire(context.Background()); err != nil {
+		t.Fatalf("Acquire() failed: %v", err)
+	}
+
+	queued := make(chan error)
+	go func() { queued <- l.Acquire(context.Background()) }()
+
+	for {
+		if _, n := l.Stats(); n == 1 {
+			break
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	if err := l.Acquire(context.Background()); err != errQueueFull {
+		t.Errorf("Acquire() with a full queue error = %v, want: %v", err, errQueueFull)
+	}
+
+	if err := <-queued; err != errQueueTimeout {
+		t.Errorf("Acquire() after the queue timeout error = %v, want: %v", err, errQueueTimeout)
+	}
+
+	if code := status.Code(errQueueTimeout); code != codes.ResourceExhausted {
+		t.Errorf("queue timeout code = %v, want: %v", code, codes.ResourceExhausted)
+	}
+
+	go func() { queued <- l.Acquire(context.Background()) }()
+
+	time.Sleep(10 * time.Millisecond)
+	l.Release()
+
+	if err := <-queued; err != nil {
+		t.Errorf("Acquire() after Release() failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := l.Acquire(ctx); status.Code(err) != codes.Canceled {
+		t.Errorf("Acquire() with a canceled context error = %v, want code: %v", err, codes.Canceled)
+	}
+
+	if active, queued := l.Stats(); active != 1 || queued != 0 {
+		t.Errorf("Stats() = %v, %v, want: 1, 0", active, queued)
+	}
+}
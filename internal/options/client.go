@@ -27,12 +27,138 @@ type Client struct{}
 
 // MaxActiveServers sets the maximum number of active servers the client will connect to.
 // Servers exceeding this limit will not receive requests.
+// Servers with higher priority are selected first; see Subsetting for the order within the same priority.
 func (Client) MaxActiveServers(count int) func(*config.Client) {
 	return func(c *config.Client) {
 		c.MaxActiveServers = count
 	}
 }
 
+// MaxConcurrentRequests limits the number of concurrent calls and streams across all servers of the client.
+// Calls over the limit wait in a queue of up to queueSize calls for at most queueTimeout;
+// a queueTimeout of 0 means they wait until their context is done.
+// Calls that do not fit into the queue or exceed the queue timeout fail with the RESOURCE_EXHAUSTED status.
+func (Client) MaxConcurrentRequests(limit, queueSize int, queueTimeout time.Duration) func(*config.Client) {
+	return func(c *config.Client) {
+		c.MaxConcurrency = &config.MaxConcurrency{
+			Requests:     limit,
+			QueueSize:    queueSize,
+			QueueTimeout: queueTimeout,
+		}
+	}
+}
+
+// WaitForServers makes calls wait for at least one server to be registered instead of failing immediately
+// with ErrNoServers. Calls wait for at most the timeout; a timeout of 0 means they wait until their context is done.
+func (Client) WaitForServers(timeout time.Duration) func(*config.Client) {
+	return func(c *config.Client) {
+		c.WaitForServers = &config.WaitForServers{
+			Timeout: timeout,
+		}
+	}
+}
+
+// Subsetting enables deterministic selection of active servers when their number is limited by MaxActiveServers.
+// Instead of the same first servers, each client selects its own stable subset within every priority,
+// so clients with different shard keys spread evenly across all servers. Adding or removing a server
+// changes at most one member of the subset. If shardKey is empty, the client ID is used.
+func (Client) Subsetting(shardKey string) func(*config.Client) {
+	return func(c *config.Client) {
+		c.Subsetting = &config.Subsetting{
+			ShardKey: shardKey,
+		}
+	}
+}
+
+// PriorityFailover enables tiered failover between server priorities.
+// Requests go only to the highest priority tier that has at least minHealthyPercent percent
+// or at least minHealthyCount ready servers. When the tier falls below these thresholds,
+// the missing share of requests overflows proportionally to the next tier.
+// A zero value disables the corresponding threshold; if both are zero,
+// all requests go to the highest priority tier that has at least one ready server.
+func (Client) PriorityFailover(minHealthyPercent, minHealthyCount int) func(*config.Client) {
+	return func(c *config.Client) {
+		c.PriorityFailover = &config.PriorityFailover{
+			MinHealthyPercent: minHealthyPercent,
+			MinHealthyCount:   minHealthyCount,
+		}
+	}
+}
+
+// TrafficSplit distributes requests between server groups identified by an attribute or label,
+// for example, 95% to servers with version 1.4 and 5% to servers with version 1.5.
+// A traffic split stored in etcd with the RPCPlatform.SetTrafficSplit method takes precedence over this option.
+func (Client) TrafficSplit(trafficSplit *config.TrafficSplit) func(*config.Client) {
+	return func(c *config.Client) {
+		c.TrafficSplit = trafficSplit
+	}
+}
+
+// Affinity routes calls that present an affinity token to the server that issued it while the server stays ready.
+// While such a server is ejected, has an open circuit breaker, is at its concurrency limit or pushed back,
+// the calls fail with the UNAVAILABLE status or wait with grpc.WaitForReady instead of going to another server.
+// Tokens are issued by servers with the Affinity option; see the AffinityToken and WithAffinityToken functions.
+func (Client) Affinity() func(*config.Client) {
+	return func(c *config.Client) {
+		c.Affinity = true
+	}
+}
+
+// SlowStart enables a gradual increase of the weight of servers that have recently become ready.
+// The effective weight of a server ramps from 10% of its BalancerWeight to the full value
+// over the window measured from the first time the server became ready.
+// Aggression controls the shape of the ramp: 1 is linear, larger values increase the weight faster
+// at the beginning of the window. Values less than or equal to 0 are treated as 1.
+func (Client) SlowStart(window time.Duration, aggression float64) func(*config.Client) {
+	return func(c *config.Client) {
+		c.SlowStart = &config.SlowStart{
+			Window:     window,
+			Aggression: aggression,
+		}
+	}
+}
+
+// OutlierDetection enables passive detection of servers that fail calls while being ready.
+// Such servers are temporarily ejected and do not receive requests until the ejection time expires.
+func (Client) OutlierDetection(outlierDetection *config.OutlierDetection) func(*config.Client) {
+	return func(c *config.Client) {
+		c.OutlierDetection = outlierDetection
+	}
+}
+
+// CircuitBreaker enables per-server circuit breakers. A breaker opens when the error rate or the number of
+// consecutive failed calls reaches its threshold and removes the server from rotation for the open time.
+// After that, the breaker becomes half-open and lets probe calls through to decide whether to close.
+// The state of breakers is available through the Client.Stats method.
+func (Client) CircuitBreaker(circuitBreaker *config.CircuitBreaker) func(*config.Client) {
+	return func(c *config.Client) {
+		c.CircuitBreaker = circuitBreaker
+	}
+}
+
+// LatencyWeighting enables a balancing mode that tracks an exponentially weighted moving average
+// of call latency per server and reduces the weight of slower servers in inverse proportion to it.
+// The effective weight never exceeds the BalancerWeight of the server.
+// Decay is the time constant over which previous observations lose their influence.
+func (Client) LatencyWeighting(decay time.Duration) func(*config.Client) {
+	return func(c *config.Client) {
+		c.LatencyWeighting = decay
+	}
+}
+
+// LoadReporting enables weighting of servers by the load they report via ORCA.
+// Servers that handle more queries per second per unit of utilization receive more requests.
+// Load reports attached to call trailers are always used; if the interval is greater than zero,
+// the client also subscribes to out-of-band load reports sent by servers at that interval.
+// Servers must enable the LoadReporting server option.
+func (Client) LoadReporting(interval time.Duration) func(*config.Client) {
+	return func(c *config.Client) {
+		c.LoadReporting = &config.LoadReporting{
+			Interval: interval,
+		}
+	}
+}
+
 // EtcdClientTimeout sets the timeout duration for server-side etcd client operations.
 // The default value is 5 seconds.
 func (Client) EtcdClientTimeout(timeout time.Duration) func(*config.Client) {
@@ -41,6 +167,15 @@ func (Client) EtcdClientTimeout(timeout time.Duration) func(*config.Client) {
 	}
 }
 
+// MethodConfig adds timeouts, retry and hedging policies for services and methods to the gRPC service config of the client.
+// Configs for the same method must not be added more than once.
+// Hedging policies are passed to gRPC as is; gRPC-Go currently does not send hedged attempts.
+func (Client) MethodConfig(methodConfigs ...*config.MethodConfig) func(*config.Client) {
+	return func(c *config.Client) {
+		c.MethodConfigs = append(c.MethodConfigs, methodConfigs...)
+	}
+}
+
 // GRPCOptions adds gRPC dial options to the client.
 func (Client) GRPCOptions(options ...grpc.DialOption) func(*config.Client) {
 	return func(c *config.Client) {
@@ -17,8 +17,15 @@
 package options
 
 import (
+	"time"
+
 	"github.com/nexcode/rpcplatform/internal/config"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
 	"go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 type Platform struct{}
@@ -39,7 +46,33 @@ func (Platform) ServerOptions(options ...func(*config.Server)) func(*config.Plat
 	}
 }
 
+// TLS configures mutual TLS for all clients and servers, overriding transport credentials set in GRPCOptions.
+// Both sides present the certificate from certFile and keyFile and verify the peer with the CA certificates from caFile.
+// Clients expect the server certificate to be issued for the target name, for example as a DNS SAN.
+// The files are reloaded when they change, without restarting clients and servers.
+func (Platform) TLS(certFile, keyFile, caFile string) func(*config.Platform) {
+	return func(c *config.Platform) {
+		c.TLS = &config.TLS{
+			CertFile: certFile,
+			KeyFile:  keyFile,
+			CAFile:   caFile,
+		}
+	}
+}
+
+// ServiceIdentity makes services identify each other by SPIFFE-style URIs, such as
+// "spiffe://trustDomain/serviceName", and requires the TLS option.
+// Servers advertise their identity in etcd, and clients verify that the server certificate
+// has the identity of the target as a URI SAN instead of checking the server name.
+// Servers can restrict calling services with ServerOptions.Authorize.
+func (Platform) ServiceIdentity(trustDomain string) func(*config.Platform) {
+	return func(c *config.Platform) {
+		c.TrustDomain = trustDomain
+	}
+}
+
 // OpenTelemetry configures OpenTelemetry tracing for clients and servers.
+// The platform creates one tracer provider shared by all of them and shuts it down in RPCPlatform.Shutdown.
 func (Platform) OpenTelemetry(serviceName string, sampleRate float64, exporters ...trace.SpanExporter) func(*config.Platform) {
 	return func(c *config.Platform) {
 		c.OpenTelemetry = &config.OpenTelemetry{
@@ -49,3 +82,80 @@ func (Platform) OpenTelemetry(serviceName string, sampleRate float64, exporters
 		}
 	}
 }
+
+// TracerProvider sets an OpenTelemetry tracer provider shared by all clients and servers.
+// It takes precedence over the OpenTelemetry option and is never shut down by the platform.
+func (Platform) TracerProvider(tracerProvider oteltrace.TracerProvider) func(*config.Platform) {
+	return func(c *config.Platform) {
+		c.TracerProvider = tracerProvider
+	}
+}
+
+// MeterProvider enables OpenTelemetry metrics for clients and servers: gRPC call metrics
+// and platform instruments for discovery, etcd leases and load balancing.
+// The meter provider is never shut down by the platform.
+func (Platform) MeterProvider(meterProvider metric.MeterProvider) func(*config.Platform) {
+	return func(c *config.Platform) {
+		c.MeterProvider = meterProvider
+	}
+}
+
+// Sampler sets the sampler of the tracer provider created by the OpenTelemetry option,
+// replacing the parent-based sampler that uses its sample rate.
+// See MethodSampler for sampling rules per gRPC method.
+func (Platform) Sampler(sampler trace.Sampler) func(*config.Platform) {
+	return func(c *config.Platform) {
+		c.Sampler = sampler
+	}
+}
+
+// Propagator sets how trace context is passed between clients and servers.
+// The default is W3C Trace Context with Baggage. B3 and Jaeger propagators
+// are available in the go.opentelemetry.io/contrib/propagators modules.
+func (Platform) Propagator(propagator propagation.TextMapPropagator) func(*config.Platform) {
+	return func(c *config.Platform) {
+		c.Propagator = propagator
+	}
+}
+
+// ExcludeFromTelemetry disables tracing and metrics for the given gRPC methods, such as health checks.
+// Methods are full method names, such as "/pkg.Service/Method", or services, such as "/pkg.Service/".
+func (Platform) ExcludeFromTelemetry(methods ...string) func(*config.Platform) {
+	return func(c *config.Platform) {
+		c.TelemetryExclude = append(c.TelemetryExclude, methods...)
+	}
+}
+
+// ResourceAttributes adds attributes, such as deployment.environment.name, service.version
+// or service.namespace, to the resource of the tracer provider created by the OpenTelemetry option.
+func (Platform) ResourceAttributes(attributes ...attribute.KeyValue) func(*config.Platform) {
+	return func(c *config.Platform) {
+		c.Resource.Attributes = append(c.Resource.Attributes, attributes...)
+	}
+}
+
+// ResourceDetectors adds detectors that contribute to the resource of the tracer provider
+// created by the OpenTelemetry option, in addition to the host, OS, container and process detectors.
+func (Platform) ResourceDetectors(detectors ...resource.Detector) func(*config.Platform) {
+	return func(c *config.Platform) {
+		c.Resource.Detectors = append(c.Resource.Detectors, detectors...)
+	}
+}
+
+// ResourceFromEnv makes the OTEL_RESOURCE_ATTRIBUTES and OTEL_SERVICE_NAME environment variables
+// override the service name and attributes set in code in the resource of the tracer provider
+// created by the OpenTelemetry option. Without it, the OpenTelemetry SDK still adds the attributes
+// from the environment that are not set in code.
+func (Platform) ResourceFromEnv() func(*config.Platform) {
+	return func(c *config.Platform) {
+		c.Resource.FromEnv = true
+	}
+}
+
+// ResourceTimeout limits the time spent detecting the resource of the tracer provider
+// created by the OpenTelemetry option. The default is 10 seconds.
+func (Platform) ResourceTimeout(timeout time.Duration) func(*config.Platform) {
+	return func(c *config.Platform) {
+		c.Resource.Timeout = timeout
+	}
+}
@@ -65,6 +65,46 @@ func (Server) Attributes(attributes *attributes.Attributes) func(*config.Server)
 	}
 }
 
+// LoadReporting enables ORCA load reports that are sent to clients in call trailers and out-of-band.
+// The reported values are set through the recorder returned by the Server.LoadRecorder method.
+// The interval is the minimum period between out-of-band reports; values less than 30 seconds are replaced with 30 seconds.
+func (Server) LoadReporting(interval time.Duration) func(*config.Server) {
+	return func(c *config.Server) {
+		c.LoadReporting = &config.LoadReporting{
+			Interval: interval,
+		}
+	}
+}
+
+// Affinity makes the server return an affinity token identifying it in the trailer of calls that do not present it.
+// Clients with the Affinity option route calls presenting the token back to this server.
+func (Server) Affinity() func(*config.Server) {
+	return func(c *config.Server) {
+		c.Affinity = true
+	}
+}
+
+// ConcurrencyLimit limits the number of calls and streams handled by the server concurrently.
+// Calls over the limit are shed with the UNAVAILABLE status and pushback metadata
+// that makes clients avoid the server with calls of the same or lower criticality for the pushback delay.
+// Calls with lower criticality are shed first; see the WithCriticality function.
+// Methods and services listed in Exempt, and the ORCA load reporting service, are not limited.
+func (Server) ConcurrencyLimit(concurrencyLimit *config.ConcurrencyLimit) func(*config.Server) {
+	return func(c *config.Server) {
+		c.ConcurrencyLimit = concurrencyLimit
+	}
+}
+
+// Authorize sets the function that decides whether the calling service may invoke the full method name,
+// such as "/pkg.Service/Method". Denied calls fail with the PERMISSION_DENIED status.
+// The peer service is taken from the verified client certificate when the platform has the
+// ServiceIdentity option, and is empty when the caller has no verified identity.
+func (Server) Authorize(authorize func(peerService, method string) bool) func(*config.Server) {
+	return func(c *config.Server) {
+		c.Authorize = authorize
+	}
+}
+
 // GRPCOptions adds gRPC server options to the server.
 func (Server) GRPCOptions(options ...grpc.ServerOption) func(*config.Server) {
 	return func(c *config.Server) {
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package retry
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+type trackerKey struct{}
+
+// Tracker records the servers tried by the attempts of a single call.
+type Tracker struct {
+	mu    sync.Mutex
+	tried []string
+}
+
+// NewContext returns a copy of ctx with a new tracker.
+func NewContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, trackerKey{}, &Tracker{})
+}
+
+func FromContext(ctx context.Context) *Tracker {
+	if ctx == nil {
+		return nil
+	}
+
+	tracker, _ := ctx.Value(trackerKey{}).(*Tracker)
+	return tracker
+}
+
+// Add records that the server with the given ID was tried.
+func (t *Tracker) Add(id string) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !slices.Contains(t.tried, id) {
+		t.tried = append(t.tried, id)
+	}
+}
+
+// Attempted reports whether any server was tried.
+func (t *Tracker) Attempted() bool {
+	if t == nil {
+		return false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return len(t.tried) != 0
+}
+
+// Tried reports whether the server with the given ID was tried.
+func (t *Tracker) Tried(id string) bool {
+	if t == nil {
+		return false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return slices.Contains(t.tried, id)
+}
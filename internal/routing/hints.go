@@ -0,0 +1,53 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package routing
+
+import (
+	"context"
+	"maps"
+)
+
+type hintsKey struct{}
+
+// Hints restrict the servers that can be picked for a call.
+type Hints struct {
+	ServerID      string
+	Attributes    map[string]string
+	PriorityFloor *int
+}
+
+func FromContext(ctx context.Context) *Hints {
+	if ctx == nil {
+		return nil
+	}
+
+	hints, _ := ctx.Value(hintsKey{}).(*Hints)
+	return hints
+}
+
+// with returns a copy of ctx with a copy of its hints modified by the update function.
+func with(ctx context.Context, update func(*Hints)) context.Context {
+	hints := &Hints{}
+
+	if parent := FromContext(ctx); parent != nil {
+		*hints = *parent
+		hints.Attributes = maps.Clone(parent.Attributes)
+	}
+
+	update(hints)
+	return context.WithValue(ctx, hintsKey{}, hints)
+}
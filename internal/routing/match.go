@@ -0,0 +1,45 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package routing
+
+import (
+	"github.com/nexcode/rpcplatform/internal/attributes"
+)
+
+// Match reports whether a server with the given ID and attributes satisfies the hints.
+// Nil hints are satisfied by any server.
+func (h *Hints) Match(id string, attrs *attributes.Attributes) bool {
+	if h == nil {
+		return true
+	}
+
+	if h.ServerID != "" && h.ServerID != id {
+		return false
+	}
+
+	if h.PriorityFloor != nil && attrs.BalancerPriority < *h.PriorityFloor {
+		return false
+	}
+
+	for key, value := range h.Attributes {
+		if v, ok := attributes.Lookup(attrs, key); !ok || v != value {
+			return false
+		}
+	}
+
+	return true
+}
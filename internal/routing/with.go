@@ -0,0 +1,43 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package routing
+
+import (
+	"context"
+)
+
+func WithServerID(ctx context.Context, id string) context.Context {
+	return with(ctx, func(hints *Hints) {
+		hints.ServerID = id
+	})
+}
+
+func WithAttributeMatch(ctx context.Context, key, value string) context.Context {
+	return with(ctx, func(hints *Hints) {
+		if hints.Attributes == nil {
+			hints.Attributes = make(map[string]string)
+		}
+
+		hints.Attributes[key] = value
+	})
+}
+
+func WithPriorityFloor(ctx context.Context, priority int) context.Context {
+	return with(ctx, func(hints *Hints) {
+		hints.PriorityFloor = &priority
+	})
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serviceconfig
+
+import (
+	"encoding/json"
+
+	"github.com/nexcode/rpcplatform/internal/config"
+)
+
+// New returns the JSON service config that selects the balancer with the given name
+// and applies the method configs.
+func New(balancerName string, methodConfigs []*config.MethodConfig) (string, error) {
+	serviceConfig := serviceConfig{
+		LoadBalancingConfig: []map[string]struct{}{{balancerName: {}}},
+	}
+
+	for _, mc := range methodConfigs {
+		if mc.RetryPolicy != nil && mc.HedgingPolicy != nil {
+			return "", errRetryAndHedging
+		}
+
+		methodConfig := methodConfig{
+			Name: make([]methodName, len(mc.Names)),
+		}
+
+		for i, name := range mc.Names {
+			methodConfig.Name[i] = methodName(name)
+		}
+
+		if mc.Timeout > 0 {
+			methodConfig.Timeout = (*duration)(&mc.Timeout)
+		}
+
+		if rp := mc.RetryPolicy; rp != nil {
+			methodConfig.RetryPolicy = &retryPolicy{
+				MaxAttempts:          rp.MaxAttempts,
+				InitialBackoff:       duration(rp.InitialBackoff),
+				MaxBackoff:           duration(rp.MaxBackoff),
+				BackoffMultiplier:    rp.BackoffMultiplier,
+				RetryableStatusCodes: rp.RetryableStatusCodes,
+			}
+		}
+
+		if hp := mc.HedgingPolicy; hp != nil {
+			methodConfig.HedgingPolicy = &hedgingPolicy{
+				MaxAttempts:         hp.MaxAttempts,
+				HedgingDelay:        duration(hp.HedgingDelay),
+				NonFatalStatusCodes: hp.NonFatalStatusCodes,
+			}
+		}
+
+		serviceConfig.MethodConfig = append(serviceConfig.MethodConfig, methodConfig)
+	}
+
+	data, err := json.Marshal(serviceConfig)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
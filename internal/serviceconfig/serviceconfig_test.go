@@ -0,0 +1,113 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serviceconfig
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nexcode/rpcplatform/internal/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		methodConfigs []*config.MethodConfig
+		expected      string
+		err           bool
+	}{
+		{
+			"Balancer only",
+			nil,
+			`{"loadBalancingConfig":[{"round_robin":{}}]}`,
+			false,
+		}, {
+			"Timeout and retry policy",
+			[]*config.MethodConfig{{
+				Names:   []config.MethodName{{Service: "helloworld.Greeter"}},
+				Timeout: 1500 * time.Millisecond,
+				RetryPolicy: &config.RetryPolicy{
+					MaxAttempts:          3,
+					InitialBackoff:       100 * time.Millisecond,
+					MaxBackoff:           time.Second,
+					BackoffMultiplier:    2,
+					RetryableStatusCodes: []codes.Code{codes.Unavailable},
+				},
+			}},
+			`{"loadBalancingConfig":[{"round_robin":{}}],"methodConfig":[{"name":[{"service":"helloworld.Greeter"}],` +
+				`"timeout":"1.5s","retryPolicy":{"maxAttempts":3,"initialBackoff":"0.1s","maxBackoff":"1s",` +
+				`"backoffMultiplier":2,"retryableStatusCodes":[14]}}]}`,
+			false,
+		}, {
+			"Hedging policy",
+			[]*config.MethodConfig{{
+				Names: []config.MethodName{{Service: "helloworld.Greeter", Method: "SayHello"}},
+				HedgingPolicy: &config.HedgingPolicy{
+					MaxAttempts:  2,
+					HedgingDelay: 50 * time.Millisecond,
+				},
+			}},
+			`{"loadBalancingConfig":[{"round_robin":{}}],"methodConfig":[{"name":[{"service":"helloworld.Greeter","method":"SayHello"}],` +
+				`"hedgingPolicy":{"maxAttempts":2,"hedgingDelay":"0.05s"}}]}`,
+			false,
+		}, {
+			"Retry and hedging policies",
+			[]*config.MethodConfig{{
+				Names:         []config.MethodName{{}},
+				RetryPolicy:   &config.RetryPolicy{},
+				HedgingPolicy: &config.HedgingPolicy{},
+			}},
+			"",
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			serviceConfig, err := New("round_robin", tt.methodConfigs)
+			if (err != nil) != tt.err {
+				t.Fatalf("New() error = %v, want error: %v", err, tt.err)
+			}
+
+			if serviceConfig != tt.expected {
+				t.Errorf("New() = %v, want: %v", serviceConfig, tt.expected)
+			}
+
+			if tt.methodConfigs == nil || err != nil {
+				return
+			}
+
+			client, err := grpc.NewClient("passthrough:///test",
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithDefaultServiceConfig(serviceConfig),
+			)
+
+			if err != nil {
+				t.Fatalf("service config rejected by gRPC: %v", err)
+			}
+
+			client.Close()
+		})
+	}
+}
@@ -0,0 +1,32 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Attribute keys used on platform spans and metrics.
+const (
+	TargetKey     = attribute.Key("rpcplatform.target")
+	ClientIDKey   = attribute.Key("rpcplatform.client.id")
+	ServerIDKey   = attribute.Key("rpcplatform.server.id")
+	ServerNameKey = attribute.Key("rpcplatform.server.name")
+	PriorityKey   = attribute.Key("rpcplatform.server.priority")
+	WeightKey     = attribute.Key("rpcplatform.server.weight")
+	RetryKey      = attribute.Key("rpcplatform.retry")
+)
@@ -0,0 +1,36 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc/stats"
+)
+
+// ExcludeFilter returns an otelgrpc filter that skips instrumentation of the methods.
+// Methods are full method names, such as "/pkg.Service/Method", or services, such as "/pkg.Service/".
+func ExcludeFilter(methods []string) otelgrpc.Filter {
+	excluded := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		excluded[method] = struct{}{}
+	}
+
+	return func(info *stats.RPCTagInfo) bool {
+		_, ok := lookupMethod(excluded, info.FullMethodName)
+		return !ok
+	}
+}
@@ -0,0 +1,37 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"strings"
+)
+
+// lookupMethod returns the value for the full method name, such as "/pkg.Service/Method",
+// falling back to the value for its service, such as "/pkg.Service/".
+func lookupMethod[T any](values map[string]T, fullMethod string) (T, bool) {
+	if value, ok := values[fullMethod]; ok {
+		return value, true
+	}
+
+	if i := strings.LastIndex(fullMethod, "/"); i > 0 {
+		value, ok := values[fullMethod[:i+1]]
+		return value, ok
+	}
+
+	var zero T
+	return zero, false
+}
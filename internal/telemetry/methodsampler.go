@@ -0,0 +1,51 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+// NewMethodSampler returns a sampler that chooses a sampler by the gRPC method of the span.
+// Rules are keyed by full method name, such as "/pkg.Service/Method", or by service, such as "/pkg.Service/".
+// Spans of other methods are sampled by fallback.
+func NewMethodSampler(rules map[string]trace.Sampler, fallback trace.Sampler) trace.Sampler {
+	return &methodSampler{
+		rules:    rules,
+		fallback: fallback,
+	}
+}
+
+type methodSampler struct {
+	rules    map[string]trace.Sampler
+	fallback trace.Sampler
+}
+
+func (s *methodSampler) ShouldSample(parameters trace.SamplingParameters) trace.SamplingResult {
+	// Span names of gRPC calls are full method names without the leading slash.
+	if sampler, ok := lookupMethod(s.rules, "/"+parameters.Name); ok {
+		return sampler.ShouldSample(parameters)
+	}
+
+	return s.fallback.ShouldSample(parameters)
+}
+
+func (s *methodSampler) Description() string {
+	return fmt.Sprintf("MethodSampler{rules:%d,fallback:%s}", len(s.rules), s.fallback.Description())
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// ScopeName is the instrumentation scope of the platform instruments.
+const ScopeName = "github.com/nexcode/rpcplatform"
+
+// NewMetrics creates the platform instruments using the meter provider.
+func NewMetrics(meterProvider metric.MeterProvider) (*Metrics, error) {
+	meter := meterProvider.Meter(ScopeName)
+
+	instances, err1 := meter.Int64Gauge("rpcplatform.client.instances",
+		metric.WithDescription("Number of server instances registered for the target."),
+		metric.WithUnit("{instance}"),
+	)
+
+	lookupUpdates, err2 := meter.Int64Counter("rpcplatform.lookup.updates",
+		metric.WithDescription("Number of server list updates received from etcd."),
+		metric.WithUnit("{update}"),
+	)
+
+	leaseRenewals, err3 := meter.Int64Counter("rpcplatform.server.lease.renewals",
+		metric.WithDescription("Number of successful etcd lease renewals."),
+		metric.WithUnit("{renewal}"),
+	)
+
+	leaseFailures, err4 := meter.Int64Counter("rpcplatform.server.lease.failures",
+		metric.WithDescription("Number of failed etcd lease grants, registrations and lost leases."),
+		metric.WithUnit("{failure}"),
+	)
+
+	pickerRebuilds, err5 := meter.Int64Counter("rpcplatform.balancer.picker.rebuilds",
+		metric.WithDescription("Number of times the balancer rebuilt its picker."),
+		metric.WithUnit("{rebuild}"),
+	)
+
+	picks, err6 := meter.Int64Counter("rpcplatform.balancer.picks",
+		metric.WithDescription("Number of call attempts assigned to a server instance."),
+		metric.WithUnit("{pick}"),
+	)
+
+	if err := errors.Join(err1, err2, err3, err4, err5, err6); err != nil {
+		return nil, err
+	}
+
+	return &Metrics{
+		instances:      instances,
+		lookupUpdates:  lookupUpdates,
+		leaseRenewals:  leaseRenewals,
+		leaseFailures:  leaseFailures,
+		pickerRebuilds: pickerRebuilds,
+		picks:          picks,
+	}, nil
+}
+
+// Metrics records platform measurements. All methods of a nil Metrics do nothing.
+type Metrics struct {
+	instances      metric.Int64Gauge
+	lookupUpdates  metric.Int64Counter
+	leaseRenewals  metric.Int64Counter
+	leaseFailures  metric.Int64Counter
+	pickerRebuilds metric.Int64Counter
+	picks          metric.Int64Counter
+}
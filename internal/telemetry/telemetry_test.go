@@ -0,0 +1,84 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"testing"
+
+	"go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc/stats"
+)
+
+func TestMethodSampler(t *testing.T) {
+	t.Parallel()
+
+	sampler := NewMethodSampler(map[string]trace.Sampler{
+		"/grpc.health.v1.Health/":    trace.NeverSample(),
+		"/test.Service/Method":       trace.NeverSample(),
+		"/test.Service/SampleAlways": trace.AlwaysSample(),
+	}, trace.AlwaysSample())
+
+	tests := []struct {
+		name     string
+		spanName string
+		expected trace.SamplingDecision
+	}{
+		{"Method rule", "test.Service/Method", trace.Drop},
+		{"Service rule", "grpc.health.v1.Health/Check", trace.Drop},
+		{"Method of service without rule", "test.Service/Other", trace.RecordAndSample},
+		{"Method rule overrides fallback", "test.Service/SampleAlways", trace.RecordAndSample},
+		{"Not a gRPC span", "custom", trace.RecordAndSample},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := sampler.ShouldSample(trace.SamplingParameters{Name: tt.spanName})
+			if result.Decision != tt.expected {
+				t.Errorf("ShouldSample(%q) = %v, want: %v", tt.spanName, result.Decision, tt.expected)
+			}
+		})
+	}
+}
+
+func TestExcludeFilter(t *testing.T) {
+	t.Parallel()
+
+	filter := ExcludeFilter([]string{"/grpc.health.v1.Health/", "/test.Service/Method"})
+
+	tests := []struct {
+		fullMethod string
+		expected   bool
+	}{
+		{"/grpc.health.v1.Health/Check", false},
+		{"/grpc.health.v1.Health/Watch", false},
+		{"/test.Service/Method", false},
+		{"/test.Service/Other", true},
+		{"/other.Service/Method", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fullMethod, func(t *testing.T) {
+			t.Parallel()
+
+			if instrumented := filter(&stats.RPCTagInfo{FullMethodName: tt.fullMethod}); instrumented != tt.expected {
+				t.Errorf("filter(%q) = %v, want: %v", tt.fullMethod, instrumented, tt.expected)
+			}
+		})
+	}
+}
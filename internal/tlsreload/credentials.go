@@ -0,0 +1,99 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tlsreload
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// ServerCredentials returns gRPC credentials that present the current certificate
+// and require client certificates signed by the current CA.
+func (r *Reloader) ServerCredentials() credentials.TransportCredentials {
+	return &transportCredentials{reloader: r}
+}
+
+// ClientCredentials returns gRPC credentials that present the current certificate
+// and verify that the server certificate is issued by the current CA for serverName.
+func (r *Reloader) ClientCredentials(serverName string) credentials.TransportCredentials {
+	return &transportCredentials{reloader: r, serverName: serverName}
+}
+
+// ClientIdentityCredentials returns gRPC credentials that present the current certificate
+// and verify that the server certificate is issued by the current CA and has the identity as a URI SAN.
+// Server names are not verified.
+func (r *Reloader) ClientIdentityCredentials(identity string) credentials.TransportCredentials {
+	return &transportCredentials{reloader: r, identity: identity}
+}
+
+// transportCredentials builds a new TLS configuration from the reloader for every handshake.
+type transportCredentials struct {
+	reloader   *Reloader
+	serverName string
+	identity   string
+}
+
+func (c *transportCredentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	certificate, pool := c.reloader.current()
+
+	// The gRPC TLS credentials verify the server certificate against the authority.
+	if c.serverName != "" {
+		authority = c.serverName
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{*certificate},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if c.identity != "" {
+		// The chain is verified by verifyIdentity, which checks the URI SAN instead of the server name.
+		config.InsecureSkipVerify = true
+		config.VerifyConnection = verifyIdentity(pool, c.identity)
+	}
+
+	return credentials.NewTLS(config).ClientHandshake(ctx, authority, conn)
+}
+
+func (c *transportCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	certificate, pool := c.reloader.current()
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{*certificate},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}).ServerHandshake(conn)
+}
+
+func (c *transportCredentials) Info() credentials.ProtocolInfo {
+	return credentials.NewTLS(nil).Info()
+}
+
+func (c *transportCredentials) Clone() credentials.TransportCredentials {
+	clone := *c
+	return &clone
+}
+
+func (c *transportCredentials) OverrideServerName(serverName string) error {
+	c.serverName = serverName
+	return nil
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tlsreload
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"sync"
+	"time"
+)
+
+// checkInterval limits how often the files are checked for changes.
+const checkInterval = time.Second
+
+// New loads the certificate, its key and the CA certificates used to verify peers.
+// The files are checked for changes during handshakes and reloaded when modified.
+func New(certFile, keyFile, caFile string) (*Reloader, error) {
+	r := &Reloader{
+		files: [3]string{certFile, keyFile, caFile},
+	}
+
+	if err := r.load(time.Now()); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Reloader holds the current TLS certificate and CA pool loaded from files.
+type Reloader struct {
+	files [3]string
+
+	mu          sync.Mutex
+	checked     time.Time
+	modTimes    [3]time.Time
+	certificate *tls.Certificate
+	pool        *x509.CertPool
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tlsreload
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"os"
+	"time"
+)
+
+// current returns the certificate and CA pool, reloading them if the files have changed.
+// A failed reload is logged and the previous values are kept, so that partially written
+// files do not break new connections.
+func (r *Reloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if now := time.Now(); now.Sub(r.checked) >= checkInterval {
+		if err := r.load(now); err != nil {
+			log.Println(err)
+		}
+	}
+
+	return r.certificate, r.pool
+}
+
+// load reads the files if any of them has changed since the last load.
+// It must be called with r.mu held or before r is shared.
+func (r *Reloader) load(now time.Time) error {
+	r.checked = now
+
+	var modTimes [3]time.Time
+
+	for i, file := range r.files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return err
+		}
+
+		modTimes[i] = info.ModTime()
+	}
+
+	if modTimes == r.modTimes {
+		return nil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(r.files[0], r.files[1])
+	if err != nil {
+		return err
+	}
+
+	caPEM, err := os.ReadFile(r.files[2])
+	if err != nil {
+		return err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return fmt.Errorf("%q: %w", r.files[2], errNoCertificates)
+	}
+
+	r.modTimes, r.certificate, r.pool = modTimes, &certificate, pool
+	return nil
+}
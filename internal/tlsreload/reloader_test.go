@@ -0,0 +1,258 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tlsreload
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type authority struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	pem         []byte
+}
+
+func newAuthority(t *testing.T) *authority {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() failed: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate() failed: %v", err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("ParseCertificate() failed: %v", err)
+	}
+
+	return &authority{
+		certificate: certificate,
+		key:         key,
+		pem:         pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue writes a certificate for dnsName, its key and the CA certificate to dir.
+func (a *authority) issue(t *testing.T, dir, dnsName string) (certFile, keyFile, caFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() failed: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: dnsName},
+		DNSNames:     []string{dnsName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, a.certificate, &key.PublicKey, a.key)
+	if err != nil {
+		t.Fatalf("CreateCertificate() failed: %v", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalECPrivateKey() failed: %v", err)
+	}
+
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	caFile = filepath.Join(dir, "ca.pem")
+
+	writeFile(t, certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	writeFile(t, keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	writeFile(t, caFile, a.pem)
+
+	return certFile, keyFile, caFile
+}
+
+func writeFile(t *testing.T, name string, data []byte) {
+	if err := os.WriteFile(name, data, 0o600); err != nil {
+		t.Fatalf("WriteFile() failed: %v", err)
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certFile, keyFile, caFile := newAuthority(t).issue(t, dir, "test")
+
+	emptyFile := filepath.Join(dir, "empty.pem")
+	writeFile(t, emptyFile, nil)
+
+	tests := []struct {
+		name     string
+		files    [3]string
+		expected error
+	}{
+		{"Valid files", [3]string{certFile, keyFile, caFile}, nil},
+		{"Missing certificate", [3]string{filepath.Join(dir, "missing.pem"), keyFile, caFile}, os.ErrNotExist},
+		{"Missing CA", [3]string{certFile, keyFile, filepath.Join(dir, "missing.pem")}, os.ErrNotExist},
+		{"No CA certificates", [3]string{certFile, keyFile, emptyFile}, errNoCertificates},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := New(tt.files[0], tt.files[1], tt.files[2])
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("New() error = %v, want: %v", err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestReloader_Credentials(t *testing.T) {
+	t.Parallel()
+
+	ca := newAuthority(t)
+
+	server, err := New(ca.issue(t, t.TempDir(), "testServer"))
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	client, err := New(ca.issue(t, t.TempDir(), "testClient"))
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	untrusted, err := New(newAuthority(t).issue(t, t.TempDir(), "testServer"))
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		client     *Reloader
+		serverName string
+		success    bool
+	}{
+		{"Trusted client and matching server name", client, "testServer", true},
+		{"Server name mismatch", client, "otherServer", false},
+		{"Client from another CA", untrusted, "testServer", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			clientConn, serverConn := net.Pipe()
+			defer clientConn.Close()
+
+			serverErr := make(chan error, 1)
+			go func() {
+				defer serverConn.Close()
+
+				_, _, err := server.ServerCredentials().ServerHandshake(serverConn)
+				serverErr <- err
+			}()
+
+			_, _, clientErr := tt.client.ClientCredentials(tt.serverName).ClientHandshake(context.Background(), "ignored", clientConn)
+			if clientErr != nil {
+				clientConn.Close()
+			}
+
+			if success := clientErr == nil && <-serverErr == nil; success != tt.success {
+				t.Errorf("handshake success = %v, want: %v (client error: %v)", success, tt.success, clientErr)
+			}
+		})
+	}
+}
+
+func TestReloader_Reload(t *testing.T) {
+	t.Parallel()
+
+	ca := newAuthority(t)
+	dir := t.TempDir()
+
+	reloader, err := New(ca.issue(t, dir, "first"))
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	assertName := func(expected string) {
+		t.Helper()
+
+		reloader.mu.Lock()
+		reloader.checked = time.Time{}
+		reloader.mu.Unlock()
+
+		certificate, _ := reloader.current()
+
+		leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+		if err != nil {
+			t.Fatalf("ParseCertificate() failed: %v", err)
+		}
+
+		if leaf.DNSNames[0] != expected {
+			t.Errorf("certificate DNS name = %v, want: %v", leaf.DNSNames[0], expected)
+		}
+	}
+
+	assertName("first")
+
+	certFile, keyFile, _ := ca.issue(t, dir, "second")
+	assertName("second")
+
+	future := time.Now().Add(time.Minute)
+	writeFile(t, keyFile, []byte("broken"))
+
+	if err := os.Chtimes(keyFile, future, future); err != nil {
+		t.Fatalf("Chtimes() failed: %v", err)
+	}
+
+	assertName("second")
+
+	if err := os.Chtimes(certFile, future, future); err != nil {
+		t.Fatalf("Chtimes() failed: %v", err)
+	}
+
+	assertName("second")
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tlsreload
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"slices"
+)
+
+// verifyIdentity returns a function that verifies the server certificate chain against
+// the CA pool and checks that the certificate has the identity as a URI SAN.
+func verifyIdentity(pool *x509.CertPool, identity string) func(tls.ConnectionState) error {
+	return func(state tls.ConnectionState) error {
+		if len(state.PeerCertificates) == 0 {
+			return errNoPeerCertificate
+		}
+
+		options := x509.VerifyOptions{
+			Roots:         pool,
+			Intermediates: x509.NewCertPool(),
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		}
+
+		for _, certificate := range state.PeerCertificates[1:] {
+			options.Intermediates.AddCert(certificate)
+		}
+
+		leaf := state.PeerCertificates[0]
+		if _, err := leaf.Verify(options); err != nil {
+			return err
+		}
+
+		if !slices.ContainsFunc(leaf.URIs, func(uri *url.URL) bool { return uri.String() == identity }) {
+			return fmt.Errorf("%q: %w", identity, errIdentityMismatch)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,37 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package waiter
+
+import (
+	"sync"
+)
+
+// New returns a waiter that blocks calls until servers are registered.
+func New() *Waiter {
+	return &Waiter{
+		registered: make(chan struct{}),
+		closed:     make(chan struct{}),
+	}
+}
+
+// Waiter tracks whether the target of a client has registered servers.
+type Waiter struct {
+	mu         sync.Mutex
+	registered chan struct{}
+	closed     chan struct{}
+	closeOnce  sync.Once
+}
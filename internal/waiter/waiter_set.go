@@ -0,0 +1,34 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package waiter
+
+// Set records whether the target has registered servers and releases waiting calls if it has.
+func (w *Waiter) Set(registered bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	select {
+	case <-w.registered:
+		if !registered {
+			w.registered = make(chan struct{})
+		}
+	default:
+		if registered {
+			close(w.registered)
+		}
+	}
+}
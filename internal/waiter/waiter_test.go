@@ -0,0 +1,72 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package waiter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nexcode/rpcplatform/internal/balancer/picker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWaiter(t *testing.T) {
+	t.Parallel()
+
+	w := New()
+
+	start := time.Now()
+	if err := w.Wait(context.Background(), 50*time.Millisecond); !errors.Is(err, picker.ErrNoServers) {
+		t.Errorf("Wait() with an expired timeout error = %v, want: %v", err, picker.ErrNoServers)
+	}
+
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("Wait() returned after %v, want at least the timeout", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := w.Wait(ctx, 0); status.Code(err) != codes.Canceled {
+		t.Errorf("Wait() with a canceled context error = %v, want code: %v", err, codes.Canceled)
+	}
+
+	done := make(chan error)
+	go func() { done <- w.Wait(context.Background(), 0) }()
+
+	time.Sleep(10 * time.Millisecond)
+	w.Set(true)
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Wait() after Set(true) failed: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Wait() was not released by Set(true)")
+	}
+
+	w.Set(true)
+	w.Set(false)
+
+	if err := w.Wait(ctx, 0); status.Code(err) != codes.Canceled {
+		t.Errorf("Wait() after Set(false) error = %v, want code: %v", err, codes.Canceled)
+	}
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package waiter
+
+import (
+	"context"
+	"time"
+
+	"github.com/nexcode/rpcplatform/internal/balancer/picker"
+	"google.golang.org/grpc/status"
+)
+
+// Wait blocks until the target has registered servers, the timeout expires, the waiter is closed or ctx is done.
+// A timeout of 0 means no limit other than ctx. When the timeout expires, Wait returns ErrNoServers,
+// so calls with WaitForReady fail as well instead of blocking in the picker.
+func (w *Waiter) Wait(ctx context.Context, timeout time.Duration) error {
+	w.mu.Lock()
+	registered := w.registered
+	w.mu.Unlock()
+
+	select {
+	case <-registered:
+		return nil
+	default:
+	}
+
+	var expired <-chan time.Time
+
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+
+		expired = timer.C
+	}
+
+	select {
+	case <-registered:
+		return nil
+	case <-expired:
+		return picker.ErrNoServers
+	case <-w.closed:
+		return nil
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package promexport
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	otelprometheus "go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/sdk/metric"
+)
+
+// New creates an exporter that serves platform metrics in the Prometheus exposition format.
+// Pass its Option to rpcplatform.New so that all clients and servers of the platform record metrics,
+// and register the exporter itself as an HTTP handler, usually on the /metrics path.
+func New() (*Exporter, error) {
+	registry := prometheus.NewRegistry()
+
+	reader, err := otelprometheus.New(otelprometheus.WithRegisterer(registry))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Exporter{
+		meterProvider: metric.NewMeterProvider(metric.WithReader(reader)),
+		handler:       promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
+	}, nil
+}
+
+// Exporter is an [http.Handler] exposing the metrics of an RPCPlatform.
+type Exporter struct {
+	meterProvider *metric.MeterProvider
+	handler       http.Handler
+}
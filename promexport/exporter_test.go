@@ -0,0 +1,89 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package promexport
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nexcode/rpcplatform"
+	etcd "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestExporter(t *testing.T) {
+	etcdAddr := os.Getenv("ETCD_ADDR")
+	if etcdAddr == "" {
+		t.Skipf("environment variable ETCD_ADDR is empty")
+	}
+
+	etcdClient, err := etcd.New(etcd.Config{
+		Endpoints:   []string{etcdAddr},
+		DialTimeout: 10 * time.Second,
+	})
+
+	if err != nil {
+		t.Fatalf("etcd client creation failed: %v", err)
+	}
+
+	t.Cleanup(func() { etcdClient.Close() })
+
+	exporter, err := New()
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	rpcp, err := rpcplatform.New("rpcplatform", etcdClient, exporter.Option(), rpcplatform.PlatformOptions.ClientOptions(
+		rpcplatform.ClientOptions.GRPCOptions(grpc.WithTransportCredentials(insecure.NewCredentials())),
+	))
+
+	if err != nil {
+		t.Fatalf("rpcplatform.New() failed: %v", err)
+	}
+
+	if _, err = rpcp.NewClient(context.Background(), "testPromExport"); err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+
+	if err := rpcp.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() failed: %v", err)
+	}
+
+	recorder := httptest.NewRecorder()
+	exporter.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+
+	body, err := io.ReadAll(recorder.Result().Body)
+	if err != nil {
+		t.Fatalf("reading response failed: %v", err)
+	}
+
+	for _, name := range []string{"rpcplatform_client_instances", "rpcplatform_lookup_updates"} {
+		if !strings.Contains(string(body), name) {
+			t.Errorf("metric %q is not exposed", name)
+		}
+	}
+
+	if err := exporter.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown() failed: %v", err)
+	}
+}
@@ -0,0 +1,42 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpcplatform
+
+import (
+	"context"
+
+	"github.com/nexcode/rpcplatform/internal/routing"
+)
+
+// WithServerID returns a copy of ctx that routes calls made with it
+// only to the server with the given ID, for example, to debug a specific instance.
+func WithServerID(ctx context.Context, id string) context.Context {
+	return routing.WithServerID(ctx, id)
+}
+
+// WithAttributeMatch returns a copy of ctx that routes calls made with it only to servers
+// whose attribute or label with the given key has the given value, for example, to pin traffic to one version.
+// Multiple matches can be combined; all of them must be satisfied.
+func WithAttributeMatch(ctx context.Context, key, value string) context.Context {
+	return routing.WithAttributeMatch(ctx, key, value)
+}
+
+// WithPriorityFloor returns a copy of ctx that routes calls made with it
+// only to servers whose BalancerPriority is greater than or equal to the given priority.
+func WithPriorityFloor(ctx context.Context, priority int) context.Context {
+	return routing.WithPriorityFloor(ctx, priority)
+}
@@ -19,9 +19,14 @@ package rpcplatform
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/nexcode/rpcplatform/internal/config"
+	"github.com/nexcode/rpcplatform/internal/telemetry"
+	"github.com/nexcode/rpcplatform/internal/tlsreload"
 	etcd "go.etcd.io/etcd/client/v3"
+	"go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 // New creates a new RPCPlatform for creating clients and servers.
@@ -51,6 +56,41 @@ func New(etcdPrefix string, etcdClient *etcd.Client, options ...PlatformOption)
 		etcdPrefix: etcdPrefix,
 		etcdClient: etcdClient,
 		config:     config,
+		clients:    make(map[*Client]struct{}),
+		servers:    make(map[*Server]struct{}),
+	}
+
+	if config.TrustDomain != "" && config.TLS == nil {
+		return nil, ErrIdentityNeedsTLS
+	}
+
+	if config.TLS != nil {
+		tls, err := tlsreload.New(config.TLS.CertFile, config.TLS.KeyFile, config.TLS.CAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcp.tls = tls
+	}
+
+	if config.TracerProvider != nil {
+		rpcp.tracerProvider = config.TracerProvider
+	} else if config.OpenTelemetry != nil {
+		tracerProvider, err := newTracerProvider(config)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcp.tracerProvider, rpcp.sdkTracerProvider = tracerProvider, tracerProvider
+	}
+
+	if config.MeterProvider != nil {
+		metrics, err := telemetry.NewMetrics(config.MeterProvider)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcp.metrics = metrics
 	}
 
 	return rpcp, nil
@@ -60,4 +100,13 @@ type RPCPlatform struct {
 	etcdPrefix string
 	etcdClient *etcd.Client
 	config     *config.Platform
+	tls        *tlsreload.Reloader
+
+	tracerProvider    oteltrace.TracerProvider
+	sdkTracerProvider *trace.TracerProvider
+	metrics           *telemetry.Metrics
+
+	mu      sync.Mutex
+	clients map[*Client]struct{}
+	servers map[*Server]struct{}
 }
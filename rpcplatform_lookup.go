@@ -33,6 +33,7 @@ func (p *RPCPlatform) Lookup(ctx context.Context, target string, watch bool) (<-
 		return nil, fmt.Errorf("%q: target is empty or contains «/»: %w", target, ErrInvalidTargetName)
 	}
 
+	name := target
 	target = p.etcdPrefix + "/" + target + "/"
 
 	resp, err := p.etcdClient.Get(ctx, target, etcd.WithPrefix())
@@ -48,6 +49,7 @@ func (p *RPCPlatform) Lookup(ctx context.Context, target string, watch bool) (<-
 
 	serverInfoTree := make(chan map[string]*ServerInfo, 1)
 	serverInfoTree <- makeServerInfo(serverInfoFlat)
+	p.metrics.LookupUpdate(name)
 
 	if !watch {
 		close(serverInfoTree)
@@ -72,6 +74,7 @@ func (p *RPCPlatform) Lookup(ctx context.Context, target string, watch bool) (<-
 			}
 
 			serverInfoTree <- makeServerInfo(serverInfoFlat)
+			p.metrics.LookupUpdate(name)
 		}
 
 		close(serverInfoTree)
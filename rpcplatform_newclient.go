@@ -23,14 +23,21 @@ import (
 	"time"
 
 	"github.com/nexcode/rpcplatform/internal/balancer"
+	"github.com/nexcode/rpcplatform/internal/balancer/picker"
 	"github.com/nexcode/rpcplatform/internal/config"
 	"github.com/nexcode/rpcplatform/internal/gears"
+	"github.com/nexcode/rpcplatform/internal/identity"
+	"github.com/nexcode/rpcplatform/internal/limiter"
 	"github.com/nexcode/rpcplatform/internal/resolver"
+	"github.com/nexcode/rpcplatform/internal/retry"
+	"github.com/nexcode/rpcplatform/internal/serviceconfig"
+	"github.com/nexcode/rpcplatform/internal/waiter"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
 )
 
 // NewClient creates a new client connecting to the specified server name.
+// The client runs until ctx is done or the Close method is called.
 func (p *RPCPlatform) NewClient(ctx context.Context, target string, options ...ClientOption) (*Client, error) {
 	if target == "" || strings.Contains(target, "/") {
 		return nil, fmt.Errorf("%q: target is empty or contains «/»: %w", target, ErrInvalidTargetName)
@@ -47,16 +54,34 @@ func (p *RPCPlatform) NewClient(ctx context.Context, target string, options ...C
 	}
 
 	c := &Client{
-		id:       gears.UID(),
-		target:   p.etcdPrefix + "/" + target + "/",
-		resolver: resolver.New(),
-		config:   config,
+		id:        gears.UID(),
+		name:      target,
+		target:    p.etcdPrefix + "/" + target + "/",
+		resolver:  resolver.New(),
+		config:    config,
+		endpoints: picker.NewEndpoints(nil),
+		metrics:   p.metrics,
+	}
+
+	c.endpoints.Instrument(target, p.metrics)
+
+	if config.WaitForServers != nil {
+		c.waiter = waiter.New()
+	}
+
+	if config.Subsetting != nil && config.Subsetting.ShardKey == "" {
+		config.Subsetting.ShardKey = c.id
 	}
 
 	ctx, cancel := context.WithCancel(ctx)
 	timer := time.AfterFunc(config.EtcdClientTimeout, func() { cancel() })
 
-	serverInfoTree, err := p.Lookup(ctx, target, true)
+	serverInfoTrees, err := p.Lookup(ctx, target, true)
+
+	var trafficSplits <-chan *TrafficSplit
+	if err == nil {
+		trafficSplits, err = p.watchTrafficSplit(ctx, target)
+	}
 
 	if !timer.Stop() {
 		<-ctx.Done()
@@ -64,39 +89,97 @@ func (p *RPCPlatform) NewClient(ctx context.Context, target string, options ...C
 	}
 
 	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	clientState := clientState{
+		serverInfoTree: <-serverInfoTrees,
+		trafficSplit:   <-trafficSplits,
+	}
+
+	c.updateState(true, clientState)
+
+	serviceConfig, err := serviceconfig.New(balancer.Name, config.MethodConfigs)
+	if err != nil {
+		cancel()
 		return nil, err
 	}
 
-	c.updateState(true, <-serverInfoTree)
+	if c.waiter != nil {
+		config.GRPCOptions = append(config.GRPCOptions,
+			grpc.WithChainUnaryInterceptor(waiter.UnaryClientInterceptor(c.waiter, config.WaitForServers.Timeout)),
+			grpc.WithChainStreamInterceptor(waiter.StreamClientInterceptor(c.waiter, config.WaitForServers.Timeout)),
+		)
+	}
+
+	if config.MaxConcurrency != nil && config.MaxConcurrency.Requests > 0 {
+		c.limiter = limiter.New(config.MaxConcurrency.Requests, config.MaxConcurrency.QueueSize, config.MaxConcurrency.QueueTimeout)
+
+		config.GRPCOptions = append(config.GRPCOptions,
+			grpc.WithChainUnaryInterceptor(limiter.UnaryClientInterceptor(c.limiter)),
+			grpc.WithChainStreamInterceptor(limiter.StreamClientInterceptor(c.limiter)),
+		)
+	}
 
 	config.GRPCOptions = append(config.GRPCOptions,
 		grpc.WithResolvers(c.resolver),
-		grpc.WithDefaultServiceConfig(`{"loadBalancingConfig":[{"`+balancer.Name+`":{}}]}`),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithChainUnaryInterceptor(retry.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(retry.StreamClientInterceptor()),
 	)
 
-	if p.config.OpenTelemetry != nil {
+	if p.tracerProvider != nil || p.config.MeterProvider != nil {
 		statsHandler, err := p.openTelemetry(c.id, nil, "")
 		if err != nil {
+			cancel()
 			return nil, err
 		}
 
 		config.GRPCOptions = append(config.GRPCOptions, grpc.WithStatsHandler(statsHandler))
 	}
 
+	if p.tls != nil {
+		credentials := p.tls.ClientCredentials(target)
+		if p.config.TrustDomain != "" {
+			credentials = p.tls.ClientIdentityCredentials(identity.New(p.config.TrustDomain, target))
+		}
+
+		config.GRPCOptions = append(config.GRPCOptions, grpc.WithTransportCredentials(credentials))
+	}
+
 	c.client, err = grpc.NewClient(c.resolver.Scheme()+":"+target, config.GRPCOptions...)
 	if err != nil {
+		cancel()
 		return nil, err
 	}
 
+	c.cancel = cancel
+	c.wg.Add(2)
+
+	p.mu.Lock()
+	p.clients[c] = struct{}{}
+	p.mu.Unlock()
+
 	go func() {
-		defer c.client.Close()
+		defer c.wg.Done()
+
+		c.watchState(clientState, serverInfoTrees, trafficSplits)
 
-		for serverInfoTree := range serverInfoTree {
-			c.updateState(false, serverInfoTree)
+		if c.waiter != nil {
+			c.waiter.Close()
 		}
+
+		c.closeErr = c.client.Close()
+
+		p.mu.Lock()
+		delete(p.clients, c)
+		p.mu.Unlock()
 	}()
 
 	go func() {
+		defer c.wg.Done()
+
 		state := c.client.GetState()
 
 		for {
@@ -21,9 +21,13 @@ import (
 	"net"
 	"strings"
 
+	"github.com/nexcode/rpcplatform/internal/admission"
+	"github.com/nexcode/rpcplatform/internal/affinity"
 	"github.com/nexcode/rpcplatform/internal/config"
 	"github.com/nexcode/rpcplatform/internal/gears"
+	"github.com/nexcode/rpcplatform/internal/identity"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/orca"
 )
 
 // NewServer creates a new server with the given name listening on addr.
@@ -55,7 +59,7 @@ func (p *RPCPlatform) NewServer(name, addr string, options ...ServerOption) (*Se
 
 	id := gears.UID()
 
-	if p.config.OpenTelemetry != nil {
+	if p.tracerProvider != nil || p.config.MeterProvider != nil {
 		if config.PublicAddr != "" {
 			addr = config.PublicAddr
 		} else {
@@ -70,12 +74,74 @@ func (p *RPCPlatform) NewServer(name, addr string, options ...ServerOption) (*Se
 		config.GRPCOptions = append(config.GRPCOptions, grpc.StatsHandler(statsHandler))
 	}
 
-	return &Server{
-		id:       id,
-		name:     p.etcdPrefix + "/" + name,
-		etcd:     p.etcdClient,
-		server:   grpc.NewServer(config.GRPCOptions...),
-		listener: listener,
-		config:   config,
-	}, nil
+	var loadRecorder orca.ServerMetricsRecorder
+
+	if config.LoadReporting != nil {
+		loadRecorder = orca.NewServerMetricsRecorder()
+		config.GRPCOptions = append(config.GRPCOptions, orca.CallMetricsServerOption(loadRecorder))
+	}
+
+	if config.Authorize != nil {
+		config.GRPCOptions = append(config.GRPCOptions,
+			grpc.ChainUnaryInterceptor(identity.UnaryServerInterceptor(p.config.TrustDomain, config.Authorize)),
+			grpc.ChainStreamInterceptor(identity.StreamServerInterceptor(p.config.TrustDomain, config.Authorize)),
+		)
+	}
+
+	if config.ConcurrencyLimit != nil && config.ConcurrencyLimit.Limit > 0 {
+		limiter := admission.New(config.ConcurrencyLimit)
+
+		config.GRPCOptions = append(config.GRPCOptions,
+			grpc.ChainUnaryInterceptor(admission.UnaryServerInterceptor(limiter)),
+			grpc.ChainStreamInterceptor(admission.StreamServerInterceptor(limiter)),
+		)
+	}
+
+	if config.Affinity {
+		config.GRPCOptions = append(config.GRPCOptions,
+			grpc.ChainUnaryInterceptor(affinity.UnaryServerInterceptor(id)),
+			grpc.ChainStreamInterceptor(affinity.StreamServerInterceptor(id)),
+		)
+	}
+
+	if p.tls != nil {
+		config.GRPCOptions = append(config.GRPCOptions, grpc.Creds(p.tls.ServerCredentials()))
+	}
+
+	var serviceIdentity string
+	if p.config.TrustDomain != "" {
+		serviceIdentity = identity.New(p.config.TrustDomain, name)
+	}
+
+	server := grpc.NewServer(config.GRPCOptions...)
+
+	if loadRecorder != nil {
+		err = orca.Register(server, orca.ServiceOptions{
+			ServerMetricsProvider: loadRecorder,
+			MinReportingInterval:  config.LoadReporting.Interval,
+		})
+
+		if err != nil {
+			listener.Close()
+			return nil, err
+		}
+	}
+
+	s := &Server{
+		id:           id,
+		identity:     serviceIdentity,
+		name:         p.etcdPrefix + "/" + name,
+		etcd:         p.etcdClient,
+		server:       server,
+		listener:     listener,
+		loadRecorder: loadRecorder,
+		config:       config,
+		platform:     p,
+	}
+
+	p.mu.Lock()
+	p.servers[s] = struct{}{}
+	p.mu.Unlock()
+
+	return s, nil
 }
@@ -17,98 +17,89 @@
 package rpcplatform
 
 import (
-	"context"
-	"errors"
-	"log"
 	"net"
 	"strconv"
-	"time"
 
+	"github.com/nexcode/rpcplatform/internal/telemetry"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
 	"go.opentelemetry.io/otel/propagation"
-	"go.opentelemetry.io/otel/sdk/resource"
-	"go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 	"google.golang.org/grpc/stats"
 )
 
 func (p *RPCPlatform) openTelemetry(instanceID string, localAddr net.Addr, publicAddr string) (stats.Handler, error) {
-	resOptions := []resource.Option{
-		resource.WithHost(),
-		resource.WithOS(),
-		resource.WithContainer(),
-		resource.WithProcess(),
-		resource.WithTelemetrySDK(),
-		resource.WithSchemaURL(semconv.SchemaURL),
-		resource.WithAttributes(semconv.ServiceName(p.config.OpenTelemetry.ServiceName)),
-		resource.WithAttributes(semconv.ServiceInstanceID(instanceID)),
+	var tracerProvider oteltrace.TracerProvider = noop.NewTracerProvider()
+	if p.tracerProvider != nil {
+		tracerProvider = p.tracerProvider
 	}
 
-	if localAddr != nil {
-		host, port, err := net.SplitHostPort(localAddr.String())
-		if err != nil {
-			return nil, err
-		}
+	var meterProvider metric.MeterProvider = metricnoop.NewMeterProvider()
+	if p.config.MeterProvider != nil {
+		meterProvider = p.config.MeterProvider
+	}
 
-		portInt, err := strconv.Atoi(port)
-		if err != nil {
-			return nil, err
-		}
+	var propagator propagation.TextMapPropagator = propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{},
+	)
 
-		resOptions = append(resOptions,
-			resource.WithAttributes(semconv.NetworkTransportKey.String(localAddr.Network())),
-			resource.WithAttributes(semconv.NetworkLocalAddress(host)),
-			resource.WithAttributes(semconv.NetworkLocalPort(portInt)),
-		)
-
-		if publicAddr != localAddr.String() {
-			host, port, err = net.SplitHostPort(publicAddr)
-			if err != nil {
-				return nil, err
-			}
-
-			portInt, err = strconv.Atoi(port)
-			if err != nil {
-				return nil, err
-			}
-		}
+	if p.config.Propagator != nil {
+		propagator = p.config.Propagator
+	}
 
-		resOptions = append(resOptions,
-			resource.WithAttributes(semconv.ServerAddress(host)),
-			resource.WithAttributes(semconv.ServerPort(portInt)),
-		)
+	handlerOptions := []otelgrpc.Option{
+		otelgrpc.WithTracerProvider(tracerProvider),
+		otelgrpc.WithMeterProvider(meterProvider),
+		otelgrpc.WithPropagators(propagator),
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	res, err := resource.New(ctx, resOptions...)
-	cancel()
+	if len(p.config.TelemetryExclude) != 0 {
+		handlerOptions = append(handlerOptions, otelgrpc.WithFilter(telemetry.ExcludeFilter(p.config.TelemetryExclude)))
+	}
 
-	if errors.Is(err, resource.ErrPartialResource) || errors.Is(err, resource.ErrSchemaURLConflict) {
-		log.Println(err)
-	} else if err != nil {
-		return nil, err
+	if localAddr == nil {
+		spanAttributes := otelgrpc.WithSpanAttributes(telemetry.ClientIDKey.String(instanceID))
+		return otelgrpc.NewClientHandler(append(handlerOptions, spanAttributes)...), nil
 	}
 
-	tpOptions := []trace.TracerProviderOption{
-		trace.WithSampler(trace.ParentBased(trace.TraceIDRatioBased(p.config.OpenTelemetry.SampleRate))),
-		trace.WithResource(res),
+	host, port, err := net.SplitHostPort(localAddr.String())
+	if err != nil {
+		return nil, err
 	}
 
-	for _, exporter := range p.config.OpenTelemetry.Exporters {
-		tpOptions = append(tpOptions, trace.WithBatcher(exporter))
+	portInt, err := strconv.Atoi(port)
+	if err != nil {
+		return nil, err
 	}
 
-	tracerProvider := otelgrpc.WithTracerProvider(
-		trace.NewTracerProvider(tpOptions...),
-	)
+	attributes := []attribute.KeyValue{
+		telemetry.ServerIDKey.String(instanceID),
+		semconv.NetworkTransportKey.String(localAddr.Network()),
+		semconv.NetworkLocalAddress(host),
+		semconv.NetworkLocalPort(portInt),
+	}
 
-	propagators := otelgrpc.WithPropagators(
-		propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}),
-	)
+	if publicAddr != localAddr.String() {
+		host, port, err = net.SplitHostPort(publicAddr)
+		if err != nil {
+			return nil, err
+		}
 
-	if localAddr != nil {
-		return otelgrpc.NewServerHandler(tracerProvider, propagators), nil
+		portInt, err = strconv.Atoi(port)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	return otelgrpc.NewClientHandler(tracerProvider, propagators), nil
+	attributes = append(attributes,
+		semconv.ServerAddress(host),
+		semconv.ServerPort(portInt),
+	)
+
+	spanAttributes := otelgrpc.WithSpanAttributes(attributes...)
+	return otelgrpc.NewServerHandler(append(handlerOptions, spanAttributes)...), nil
 }
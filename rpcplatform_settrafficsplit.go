@@ -0,0 +1,48 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpcplatform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// SetTrafficSplit stores the traffic split for servers with the given name in etcd.
+// All clients of these servers apply it immediately, overriding the TrafficSplit client option.
+// A nil trafficSplit removes the stored value.
+func (p *RPCPlatform) SetTrafficSplit(ctx context.Context, target string, trafficSplit *TrafficSplit) error {
+	if target == "" || strings.Contains(target, "/") {
+		return fmt.Errorf("%q: target is empty or contains «/»: %w", target, ErrInvalidTargetName)
+	}
+
+	key := p.etcdPrefix + trafficSplitPrefix + "/" + target
+
+	if trafficSplit == nil {
+		_, err := p.etcdClient.Delete(ctx, key)
+		return err
+	}
+
+	value, err := json.Marshal(trafficSplit)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.etcdClient.Put(ctx, key, string(value))
+	return err
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpcplatform
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Shutdown gracefully stops all servers created by the platform, removing them from etcd first,
+// then closes all its clients and flushes and shuts down the tracer provider it created.
+// Servers wait for running calls regardless of the StopTimeout option, and those still stopping
+// when ctx is done are stopped immediately. Errors are reported together.
+func (p *RPCPlatform) Shutdown(ctx context.Context) error {
+	p.mu.Lock()
+	servers, clients := p.servers, p.clients
+	p.servers, p.clients = make(map[*Server]struct{}), make(map[*Client]struct{})
+	p.mu.Unlock()
+
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		errs []error
+	)
+
+	for server := range servers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := server.stop(ctx); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	for client := range clients {
+		if err := client.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if p.sdkTracerProvider != nil {
+		if err := p.sdkTracerProvider.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
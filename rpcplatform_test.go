@@ -18,17 +18,42 @@ package rpcplatform
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/url"
 	"os"
+	"path/filepath"
+	"reflect"
 	"slices"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/nexcode/rpcplatform/internal/attributes"
 	etcd "go.etcd.io/etcd/client/v3"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func TestNew(t *testing.T) {
@@ -46,8 +71,12 @@ func TestNew(t *testing.T) {
 		otelExportersLen *int
 		clientOptionsLen *int
 		serverOptionsLen *int
+		tracerProvider   oteltrace.TracerProvider
+		ownsTracer       *bool
 	}
 
+	externalTracerProvider := noop.NewTracerProvider()
+
 	tests := []struct {
 		name     string
 		input    input
@@ -98,6 +127,19 @@ func TestNew(t *testing.T) {
 				otelExportersLen: pointer(2),
 				serverOptionsLen: pointer(2),
 				clientOptionsLen: pointer(2),
+				ownsTracer:       pointer(true),
+			},
+		}, {
+			"Provide external tracer provider",
+			input{
+				options: []PlatformOption{
+					PlatformOptions.OpenTelemetry("testName", 0.5, tracetest.NewInMemoryExporter()),
+					PlatformOptions.TracerProvider(externalTracerProvider),
+				},
+			},
+			expected{
+				tracerProvider: externalTracerProvider,
+				ownsTracer:     pointer(false),
 			},
 		},
 	}
@@ -150,6 +192,93 @@ func TestNew(t *testing.T) {
 					t.Errorf("ClientOptions length = %v, want: %v", len(rpcp.config.ClientOptions), *tt.expected.clientOptionsLen)
 				}
 			}
+
+			if tt.expected.tracerProvider != nil {
+				if rpcp.tracerProvider != tt.expected.tracerProvider {
+					t.Errorf("tracerProvider = %v, want: %v", rpcp.tracerProvider, tt.expected.tracerProvider)
+				}
+			}
+
+			if tt.expected.ownsTracer != nil {
+				if owns := rpcp.sdkTracerProvider != nil; owns != *tt.expected.ownsTracer {
+					t.Errorf("owns tracer provider = %v, want: %v", owns, *tt.expected.ownsTracer)
+				}
+			}
+		})
+	}
+}
+
+func TestNew_Resource(t *testing.T) {
+	t.Setenv("OTEL_SERVICE_NAME", "envName")
+	t.Setenv("OTEL_RESOURCE_ATTRIBUTES", "service.namespace=envNamespace,deployment.environment.name=envEnvironment")
+
+	namespaceDetector := resource.StringDetector(semconv.SchemaURL, semconv.ServiceNamespaceKey, func() (string, error) {
+		return "detectedNamespace", nil
+	})
+
+	tests := []struct {
+		name        string
+		options     []PlatformOption
+		service     string
+		namespace   string
+		version     string
+		environment string
+	}{
+		{
+			"Code overrides environment",
+			[]PlatformOption{
+				PlatformOptions.ResourceAttributes(semconv.ServiceVersion("1.2.3")),
+				PlatformOptions.ResourceDetectors(namespaceDetector),
+			},
+			"testName", "detectedNamespace", "1.2.3", "envEnvironment",
+		}, {
+			"Environment overrides code",
+			[]PlatformOption{
+				PlatformOptions.ResourceAttributes(semconv.ServiceVersion("1.2.3")),
+				PlatformOptions.ResourceDetectors(namespaceDetector),
+				PlatformOptions.ResourceFromEnv(),
+			},
+			"envName", "envNamespace", "1.2.3", "envEnvironment",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter := keepingExporter{tracetest.NewInMemoryExporter()}
+			options := append(tt.options, PlatformOptions.OpenTelemetry("testName", 1, exporter))
+
+			rpcp, err := New("rpcplatform", &etcd.Client{}, options...)
+			if err != nil {
+				t.Fatalf("New() failed: %v", err)
+			}
+
+			_, span := rpcp.tracerProvider.Tracer("test").Start(context.Background(), "test")
+			span.End()
+
+			if err := rpcp.Shutdown(context.Background()); err != nil {
+				t.Fatalf("Shutdown() failed: %v", err)
+			}
+
+			spans := exporter.GetSpans()
+			if len(spans) != 1 {
+				t.Fatalf("spans length = %v, want: 1", len(spans))
+			}
+
+			expected := map[attribute.Key]string{
+				semconv.ServiceNameKey:      tt.service,
+				semconv.ServiceNamespaceKey: tt.namespace,
+				semconv.ServiceVersionKey:   tt.version,
+
+				// The SDK merges the environment into the resource even without the ResourceFromEnv option.
+				semconv.DeploymentEnvironmentNameKey: tt.environment,
+			}
+
+			attributes := spans[0].Resource.Set()
+			for key, want := range expected {
+				if got, _ := attributes.Value(key); got.AsString() != want {
+					t.Errorf("resource attribute %v = %v, want: %v", key, got.AsString(), want)
+				}
+			}
 		})
 	}
 }
@@ -168,6 +297,7 @@ func TestRPCPlatform_Lookup(t *testing.T) {
 	attrs := NewAttributes()
 	attrs.BalancerWeight = 10
 	attrs.BalancerPriority = 20
+	attrs.Labels = map[string]string{"version": "1.4"}
 
 	serverName := "testLookup"
 	publicAddr := "1.2.3.4:56789"
@@ -216,6 +346,54 @@ func TestRPCPlatform_Lookup(t *testing.T) {
 	t.Errorf("channel closed by timeout or unexpectedly")
 }
 
+func TestRPCPlatform_SetTrafficSplit(t *testing.T) {
+	t.Parallel()
+
+	etcdClient := getEtcdClient(t)
+	t.Cleanup(func() { etcdClient.Close() })
+
+	rpcp, err := New("rpcplatform", etcdClient)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	target := "testSetTrafficSplit"
+	trafficSplit := &TrafficSplit{Key: "version", Weights: map[string]int{"1.4": 95, "1.5": 5}}
+
+	if err = rpcp.SetTrafficSplit(ctx, target, trafficSplit); err != nil {
+		t.Fatalf("SetTrafficSplit() failed: %v", err)
+	}
+
+	serverInfoTrees, err := rpcp.Lookup(ctx, target, false)
+	if err != nil {
+		t.Fatalf("Lookup() failed: %v", err)
+	}
+
+	if serverInfoTree := <-serverInfoTrees; len(serverInfoTree) != 0 {
+		t.Errorf("servers = %v, want: none", serverInfoTree)
+	}
+
+	trafficSplits, err := rpcp.watchTrafficSplit(ctx, target)
+	if err != nil {
+		t.Fatalf("watchTrafficSplit() failed: %v", err)
+	}
+
+	if value := <-trafficSplits; !reflect.DeepEqual(value, trafficSplit) {
+		t.Errorf("TrafficSplit = %+v, want: %+v", value, trafficSplit)
+	}
+
+	if err = rpcp.SetTrafficSplit(ctx, target, nil); err != nil {
+		t.Fatalf("SetTrafficSplit() failed: %v", err)
+	}
+
+	if value := <-trafficSplits; value != nil {
+		t.Errorf("TrafficSplit = %+v, want: nil", value)
+	}
+}
+
 func TestRPCPlatform_NewClient(t *testing.T) {
 	t.Parallel()
 
@@ -288,7 +466,7 @@ func TestRPCPlatform_NewClient(t *testing.T) {
 				},
 			},
 			expected{
-				grpcOptionsLen: pointer(6 + 2), // NewClient adds 2 additional options
+				grpcOptionsLen: pointer(6 + 4), // NewClient adds 4 additional options
 			},
 		},
 	}
@@ -339,6 +517,149 @@ func TestRPCPlatform_NewClient(t *testing.T) {
 	}
 }
 
+func TestClient_WaitForServers(t *testing.T) {
+	t.Parallel()
+
+	etcdClient := getEtcdClient(t)
+	t.Cleanup(func() { etcdClient.Close() })
+
+	rpcp, err := New("rpcplatform", etcdClient, PlatformOptions.ClientOptions(
+		ClientOptions.GRPCOptions(grpc.WithTransportCredentials(insecure.NewCredentials())),
+	))
+
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		options     []ClientOption
+		callOptions []grpc.CallOption
+		wait        time.Duration
+		expected    codes.Code
+	}{
+		{"Fail immediately", nil, nil, 0, codes.Unavailable},
+		{"Wait for ready", nil, []grpc.CallOption{grpc.WaitForReady(true)}, 200 * time.Millisecond, codes.DeadlineExceeded},
+		{"Wait for servers", []ClientOption{ClientOptions.WaitForServers(100 * time.Millisecond)}, nil, 100 * time.Millisecond, codes.Unavailable},
+		{"Wait for servers and ready", []ClientOption{ClientOptions.WaitForServers(100 * time.Millisecond)},
+			[]grpc.CallOption{grpc.WaitForReady(true)}, 100 * time.Millisecond, codes.Unavailable},
+	}
+
+	for _, tt := range tests {
+		client, err := rpcp.NewClient(context.Background(), "testWaitForServers", tt.options...)
+		if err != nil {
+			t.Fatalf("%v: NewClient() failed: %v", tt.name, err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+
+		start := time.Now()
+		err = client.Client().Invoke(ctx, "/test.Service/Method", &emptypb.Empty{}, &emptypb.Empty{}, tt.callOptions...)
+		elapsed := time.Since(start)
+
+		cancel()
+
+		if tt.expected == codes.Unavailable && !errors.Is(err, ErrNoServers) {
+			t.Errorf("%v: Invoke() error = %v, want: %v", tt.name, err, ErrNoServers)
+		} else if status.Code(err) != tt.expected {
+			t.Errorf("%v: Invoke() error = %v, want code: %v", tt.name, err, tt.expected)
+		}
+
+		if elapsed < tt.wait {
+			t.Errorf("%v: Invoke() returned after %v, want at least %v", tt.name, elapsed, tt.wait)
+		}
+	}
+}
+
+func TestClient_Close(t *testing.T) {
+	t.Parallel()
+
+	etcdClient := getEtcdClient(t)
+	t.Cleanup(func() { etcdClient.Close() })
+
+	rpcp, err := New("rpcplatform", etcdClient, PlatformOptions.ClientOptions(
+		ClientOptions.GRPCOptions(grpc.WithTransportCredentials(insecure.NewCredentials())),
+	))
+
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	client, err := rpcp.NewClient(context.Background(), "testClientClose", ClientOptions.WaitForServers(0))
+	if err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+
+	invokeErr := make(chan error)
+	go func() {
+		invokeErr <- client.Client().Invoke(context.Background(), "/test.Service/Method", &emptypb.Empty{}, &emptypb.Empty{})
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+
+	var wg sync.WaitGroup
+
+	for range 2 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := client.Close(); err != nil {
+				t.Errorf("Close() failed: %v", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if err := client.Close(); err != nil {
+		t.Errorf("repeated Close() failed: %v", err)
+	}
+
+	if state := client.Client().GetState(); state != connectivity.Shutdown {
+		t.Errorf("gRPC client state = %v, want: %v", state, connectivity.Shutdown)
+	}
+
+	select {
+	case err := <-invokeErr:
+		if status.Code(err) != codes.Canceled {
+			t.Errorf("Invoke() in flight error = %v, want code: %v", err, codes.Canceled)
+		}
+	case <-time.After(time.Second):
+		t.Error("Invoke() in flight was not released by Close()")
+	}
+}
+
+func TestClient_WatchState(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client := &Client{cancel: cancel}
+
+	// The server watch ends only when the context of the client is canceled.
+	serverInfoTrees := make(chan map[string]*ServerInfo)
+	go func() {
+		<-ctx.Done()
+		close(serverInfoTrees)
+	}()
+
+	trafficSplits := make(chan *TrafficSplit)
+	close(trafficSplits)
+
+	done := make(chan struct{})
+	go func() {
+		client.watchState(clientState{}, serverInfoTrees, trafficSplits)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("watchState() did not return after the traffic split watch ended")
+	}
+}
+
 func TestRPCPlatform_NewServer(t *testing.T) {
 	t.Parallel()
 
@@ -355,6 +676,7 @@ func TestRPCPlatform_NewServer(t *testing.T) {
 		addr           bool
 		publicAddr     *string
 		attributes     *Attributes
+		loadRecorder   bool
 		grpcOptionsLen *int
 	}
 
@@ -417,6 +739,17 @@ func TestRPCPlatform_NewServer(t *testing.T) {
 					BalancerPriority: 20,
 				},
 			},
+		}, {
+			"Provide LoadReporting option",
+			input{
+				name: "testNewServer",
+				serverOptions: []ServerOption{
+					ServerOptions.LoadReporting(time.Minute),
+				},
+			},
+			expected{
+				loadRecorder: true,
+			},
 		}, {
 			"Provide options that gRPC relies on",
 			input{
@@ -484,6 +817,10 @@ func TestRPCPlatform_NewServer(t *testing.T) {
 				}
 			}
 
+			if tt.expected.loadRecorder && server.LoadRecorder() == nil {
+				t.Error("LoadRecorder is nil")
+			}
+
 			if tt.expected.grpcOptionsLen != nil {
 				if len(server.config.GRPCOptions) != *tt.expected.grpcOptionsLen {
 					t.Errorf("GRPCOptions length = %v, want: %v", len(server.config.GRPCOptions), *tt.expected.grpcOptionsLen)
@@ -510,6 +847,488 @@ func TestRPCPlatform_NewServer(t *testing.T) {
 	}
 }
 
+func TestRPCPlatform_MeterProvider(t *testing.T) {
+	t.Parallel()
+
+	etcdClient := getEtcdClient(t)
+	t.Cleanup(func() { etcdClient.Close() })
+
+	reader := metric.NewManualReader()
+
+	rpcp, err := New("rpcplatform", etcdClient,
+		PlatformOptions.MeterProvider(metric.NewMeterProvider(metric.WithReader(reader))),
+		PlatformOptions.ClientOptions(
+			ClientOptions.GRPCOptions(grpc.WithTransportCredentials(insecure.NewCredentials())),
+			ClientOptions.WaitForServers(time.Second),
+		),
+	)
+
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	server, err := rpcp.NewServer("testMeterProvider", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("NewServer() failed: %v", err)
+	}
+
+	healthpb.RegisterHealthServer(server.Server(), health.NewServer())
+	go server.Serve(context.Background())
+
+	client, err := rpcp.NewClient(context.Background(), "testMeterProvider")
+	if err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+
+	_, err = healthpb.NewHealthClient(client.Client()).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("Check() failed: %v", err)
+	}
+
+	if err := rpcp.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() failed: %v", err)
+	}
+
+	var resourceMetrics metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &resourceMetrics); err != nil {
+		t.Fatalf("Collect() failed: %v", err)
+	}
+
+	names := make(map[string]bool)
+	for _, scopeMetrics := range resourceMetrics.ScopeMetrics {
+		for _, m := range scopeMetrics.Metrics {
+			names[m.Name] = true
+
+			if m.Name != "rpcplatform.balancer.picks" {
+				continue
+			}
+
+			for _, point := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				if id, _ := point.Attributes.Value("rpcplatform.server.id"); id.AsString() != server.ID() {
+					t.Errorf("picks server ID = %v, want: %v", id.AsString(), server.ID())
+				}
+			}
+		}
+	}
+
+	for _, name := range []string{
+		"rpc.client.duration",
+		"rpc.server.duration",
+		"rpcplatform.client.instances",
+		"rpcplatform.lookup.updates",
+		"rpcplatform.balancer.picker.rebuilds",
+		"rpcplatform.balancer.picks",
+	} {
+		if !names[name] {
+			t.Errorf("metric %q was not recorded", name)
+		}
+	}
+}
+
+func TestClient_SpanAttributes(t *testing.T) {
+	t.Parallel()
+
+	etcdClient := getEtcdClient(t)
+	t.Cleanup(func() { etcdClient.Close() })
+
+	exporter := tracetest.NewInMemoryExporter()
+
+	rpcp, err := New("rpcplatform", etcdClient,
+		PlatformOptions.TracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))),
+		PlatformOptions.ClientOptions(
+			ClientOptions.GRPCOptions(grpc.WithTransportCredentials(insecure.NewCredentials())),
+			ClientOptions.WaitForServers(time.Second),
+		),
+	)
+
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	t.Cleanup(func() { rpcp.Shutdown(context.Background()) })
+
+	attrs := NewAttributes()
+	attrs.BalancerPriority = 2
+	attrs.BalancerWeight = 3
+
+	server, err := rpcp.NewServer("testSpanAttributes", "127.0.0.1:0", ServerOptions.Attributes(attrs))
+
+	if err != nil {
+		t.Fatalf("NewServer() failed: %v", err)
+	}
+
+	healthpb.RegisterHealthServer(server.Server(), health.NewServer())
+	go server.Serve(context.Background())
+
+	client, err := rpcp.NewClient(context.Background(), "testSpanAttributes")
+	if err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+
+	_, err = healthpb.NewHealthClient(client.Client()).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("Check() failed: %v", err)
+	}
+
+	expected := map[attribute.Key]attribute.Value{
+		"rpcplatform.target":          attribute.StringValue("testSpanAttributes"),
+		"rpcplatform.server.id":       attribute.StringValue(server.ID()),
+		"rpcplatform.server.priority": attribute.IntValue(2),
+		"rpcplatform.server.weight":   attribute.IntValue(3),
+		"rpcplatform.retry":           attribute.BoolValue(false),
+	}
+
+	var found bool
+
+	for _, span := range exporter.GetSpans() {
+		if span.SpanKind != oteltrace.SpanKindClient {
+			continue
+		}
+
+		found = true
+		attributes := attribute.NewSet(span.Attributes...)
+
+		for key, want := range expected {
+			if got, _ := attributes.Value(key); got != want {
+				t.Errorf("span attribute %v = %v, want: %v", key, got.Emit(), want.Emit())
+			}
+		}
+	}
+
+	if !found {
+		t.Error("client span was not recorded")
+	}
+}
+
+func TestRPCPlatform_Shutdown(t *testing.T) {
+	t.Parallel()
+
+	etcdClient := getEtcdClient(t)
+	t.Cleanup(func() { etcdClient.Close() })
+
+	exporter := keepingExporter{tracetest.NewInMemoryExporter()}
+
+	rpcp, err := New("rpcplatform", etcdClient,
+		PlatformOptions.OpenTelemetry("testName", 1, exporter),
+		PlatformOptions.ClientOptions(
+			ClientOptions.GRPCOptions(grpc.WithTransportCredentials(insecure.NewCredentials())),
+			ClientOptions.WaitForServers(time.Second),
+		),
+	)
+
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	started := make(chan struct{})
+
+	// The handler of unknown methods answers after a delay to check that running calls complete.
+	server, err := rpcp.NewServer("testShutdown", "127.0.0.1:0", ServerOptions.GRPCOptions(
+		grpc.UnknownServiceHandler(func(_ any, stream grpc.ServerStream) error {
+			if err := stream.RecvMsg(&emptypb.Empty{}); err != nil {
+				return err
+			}
+
+			close(started)
+			time.Sleep(100 * time.Millisecond)
+
+			return stream.SendMsg(&emptypb.Empty{})
+		}),
+	))
+
+	if err != nil {
+		t.Fatalf("NewServer() failed: %v", err)
+	}
+
+	serveErr := make(chan error)
+	go func() {
+		serveErr <- server.Serve(context.Background())
+	}()
+
+	client, err := rpcp.NewClient(context.Background(), "testShutdown")
+	if err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+
+	invokeErr := make(chan error)
+	go func() {
+		invokeErr <- client.Client().Invoke(context.Background(), "/test.Service/Method", &emptypb.Empty{}, &emptypb.Empty{})
+	}()
+
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := rpcp.Shutdown(ctx); err != nil {
+		t.Errorf("Shutdown() failed: %v", err)
+	}
+
+	if err := <-invokeErr; err != nil {
+		t.Errorf("Invoke() running during Shutdown() failed: %v", err)
+	}
+
+	select {
+	case err := <-serveErr:
+		if err != nil {
+			t.Errorf("Serve() failed: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Error("Serve() was not stopped by Shutdown()")
+	}
+
+	resp, err := etcdClient.Get(context.Background(), server.name+"/"+server.ID(), etcd.WithPrefix())
+	if err != nil {
+		t.Fatalf("etcd Get() failed: %v", err)
+	}
+
+	if resp.Count != 0 {
+		t.Errorf("server keys left in etcd = %d, want: 0", resp.Count)
+	}
+
+	if state := client.Client().GetState(); state != connectivity.Shutdown {
+		t.Errorf("gRPC client state = %v, want: %v", state, connectivity.Shutdown)
+	}
+
+	if len(exporter.GetSpans()) == 0 {
+		t.Error("spans were not flushed by Shutdown()")
+	}
+
+	if err := rpcp.Shutdown(ctx); err != nil {
+		t.Errorf("repeated Shutdown() failed: %v", err)
+	}
+}
+
+func TestRPCPlatform_TLS(t *testing.T) {
+	t.Parallel()
+
+	etcdClient := getEtcdClient(t)
+	t.Cleanup(func() { etcdClient.Close() })
+
+	certFile, keyFile := writeSelfSignedCertificate(t, "testTLS")
+
+	rpcp, err := New("rpcplatform", etcdClient,
+		PlatformOptions.TLS(certFile, keyFile, certFile),
+		PlatformOptions.ClientOptions(ClientOptions.WaitForServers(time.Second)),
+	)
+
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	t.Cleanup(func() { rpcp.Shutdown(context.Background()) })
+
+	for _, name := range []string{"testTLS", "testTLSMismatch"} {
+		server, err := rpcp.NewServer(name, "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("NewServer() failed: %v", err)
+		}
+
+		healthpb.RegisterHealthServer(server.Server(), health.NewServer())
+		go server.Serve(context.Background())
+	}
+
+	tests := []struct {
+		target   string
+		expected codes.Code
+	}{
+		{"testTLS", codes.OK},
+		{"testTLSMismatch", codes.Unavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := rpcp.NewClient(context.Background(), tt.target)
+			if err != nil {
+				t.Fatalf("NewClient() failed: %v", err)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			_, err = healthpb.NewHealthClient(client.Client()).Check(ctx, &healthpb.HealthCheckRequest{})
+			if status.Code(err) != tt.expected {
+				t.Errorf("Check() error = %v, want code: %v", err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRPCPlatform_ServiceIdentity(t *testing.T) {
+	t.Parallel()
+
+	etcdClient := getEtcdClient(t)
+	t.Cleanup(func() { etcdClient.Close() })
+
+	if _, err := New("rpcplatform", etcdClient, PlatformOptions.ServiceIdentity("test")); !errors.Is(err, ErrIdentityNeedsTLS) {
+		t.Errorf("New() without TLS error = %v, want: %v", err, ErrIdentityNeedsTLS)
+	}
+
+	services := []string{"testIdentity", "testIdentityAllowed", "testIdentityDenied"}
+	certFiles := make(map[string][2]string)
+
+	var caPEM []byte
+
+	for _, service := range services {
+		certFile, keyFile := writeSelfSignedCertificate(t, service, "spiffe://test/"+service)
+		certFiles[service] = [2]string{certFile, keyFile}
+
+		certPEM, err := os.ReadFile(certFile)
+		if err != nil {
+			t.Fatalf("ReadFile() failed: %v", err)
+		}
+
+		caPEM = append(caPEM, certPEM...)
+	}
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatalf("WriteFile() failed: %v", err)
+	}
+
+	newPlatform := func(service string) *RPCPlatform {
+		rpcp, err := New("rpcplatform", etcdClient,
+			PlatformOptions.TLS(certFiles[service][0], certFiles[service][1], caFile),
+			PlatformOptions.ServiceIdentity("test"),
+			PlatformOptions.ClientOptions(ClientOptions.WaitForServers(time.Second)),
+		)
+
+		if err != nil {
+			t.Fatalf("New() failed: %v", err)
+		}
+
+		t.Cleanup(func() { rpcp.Shutdown(context.Background()) })
+		return rpcp
+	}
+
+	serverPlatform := newPlatform("testIdentity")
+
+	// testIdentityImpostor presents the certificate of testIdentity.
+	for _, name := range []string{"testIdentity", "testIdentityImpostor"} {
+		server, err := serverPlatform.NewServer(name, "127.0.0.1:0", ServerOptions.Authorize(func(peerService, method string) bool {
+			return peerService == "testIdentityAllowed" && method == "/grpc.health.v1.Health/Check"
+		}))
+
+		if err != nil {
+			t.Fatalf("NewServer() failed: %v", err)
+		}
+
+		healthpb.RegisterHealthServer(server.Server(), health.NewServer())
+		go server.Serve(context.Background())
+	}
+
+	tests := []struct {
+		name     string
+		caller   string
+		target   string
+		expected codes.Code
+	}{
+		{"Allowed caller", "testIdentityAllowed", "testIdentity", codes.OK},
+		{"Denied caller", "testIdentityDenied", "testIdentity", codes.PermissionDenied},
+		{"Server with identity of another service", "testIdentityAllowed", "testIdentityImpostor", codes.Unavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := newPlatform(tt.caller).NewClient(context.Background(), tt.target)
+			if err != nil {
+				t.Fatalf("NewClient() failed: %v", err)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			_, err = healthpb.NewHealthClient(client.Client()).Check(ctx, &healthpb.HealthCheckRequest{})
+			if status.Code(err) != tt.expected {
+				t.Errorf("Check() error = %v, want code: %v", err, tt.expected)
+			}
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	serverInfos, err := serverPlatform.Lookup(ctx, "testIdentity", true)
+	if err != nil {
+		t.Fatalf("Lookup() failed: %v", err)
+	}
+
+	serverInfoTree := <-serverInfos
+	for len(serverInfoTree) == 0 {
+		serverInfoTree = <-serverInfos
+	}
+
+	for _, serverInfo := range serverInfoTree {
+		if serverInfo.Identity != "spiffe://test/testIdentity" {
+			t.Errorf("ServerInfo.Identity = %v, want: %v", serverInfo.Identity, "spiffe://test/testIdentity")
+		}
+	}
+}
+
+// writeSelfSignedCertificate writes a certificate for dnsName and URI SANs that is also its own CA.
+func writeSelfSignedCertificate(t *testing.T, dnsName string, uris ...string) (certFile, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() failed: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		DNSNames:              []string{dnsName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	for _, uri := range uris {
+		parsed, err := url.Parse(uri)
+		if err != nil {
+			t.Fatalf("url.Parse() failed: %v", err)
+		}
+
+		template.URIs = append(template.URIs, parsed)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate() failed: %v", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalECPrivateKey() failed: %v", err)
+	}
+
+	certFile = filepath.Join(t.TempDir(), "cert.pem")
+	keyFile = filepath.Join(t.TempDir(), "key.pem")
+
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("WriteFile() failed: %v", err)
+	}
+
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatalf("WriteFile() failed: %v", err)
+	}
+
+	return certFile, keyFile
+}
+
+// keepingExporter keeps the exported spans when the tracer provider shuts it down.
+type keepingExporter struct {
+	*tracetest.InMemoryExporter
+}
+
+func (keepingExporter) Shutdown(context.Context) error {
+	return nil
+}
+
 func getEtcdClient(t *testing.T) *etcd.Client {
 	etcdAddr := os.Getenv("ETCD_ADDR")
 	if etcdAddr == "" {
@@ -0,0 +1,64 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpcplatform
+
+import (
+	"context"
+
+	"github.com/nexcode/rpcplatform/internal/config"
+	etcd "go.etcd.io/etcd/client/v3"
+)
+
+// watchTrafficSplit returns the traffic split stored in etcd for servers with the given name.
+// The returned channel sends the stored value, or nil if there is none, and then every change until ctx is done.
+func (p *RPCPlatform) watchTrafficSplit(ctx context.Context, target string) (<-chan *config.TrafficSplit, error) {
+	key := p.etcdPrefix + trafficSplitPrefix + "/" + target
+
+	resp, err := p.etcdClient.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var trafficSplit *config.TrafficSplit
+	if len(resp.Kvs) != 0 {
+		trafficSplit = makeTrafficSplit(resp.Kvs[0].Value)
+	}
+
+	trafficSplits := make(chan *config.TrafficSplit, 1)
+	trafficSplits <- trafficSplit
+
+	go func() {
+		watchChan := p.etcdClient.Watch(ctx, key, etcd.WithRev(resp.Header.Revision+1))
+
+		for data := range watchChan {
+			for _, event := range data.Events {
+				switch event.Type {
+				case etcd.EventTypeDelete:
+					trafficSplit = nil
+				case etcd.EventTypePut:
+					trafficSplit = makeTrafficSplit(event.Kv.Value)
+				}
+			}
+
+			trafficSplits <- trafficSplit
+		}
+
+		close(trafficSplits)
+	}()
+
+	return trafficSplits, nil
+}
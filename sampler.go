@@ -0,0 +1,30 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpcplatform
+
+import (
+	"github.com/nexcode/rpcplatform/internal/telemetry"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+// MethodSampler returns a sampler that chooses a sampler by the gRPC method of the span,
+// for example to never sample health checks. Rules are keyed by full method name,
+// such as "/pkg.Service/Method", or by service, such as "/pkg.Service/".
+// Spans of other methods are sampled by fallback.
+func MethodSampler(rules map[string]trace.Sampler, fallback trace.Sampler) trace.Sampler {
+	return telemetry.NewMethodSampler(rules, fallback)
+}
@@ -17,18 +17,29 @@
 package rpcplatform
 
 import (
+	"context"
 	"net"
+	"sync"
 
 	"github.com/nexcode/rpcplatform/internal/config"
 	etcd "go.etcd.io/etcd/client/v3"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/orca"
 )
 
 type Server struct {
-	id       string
-	name     string
-	etcd     *etcd.Client
-	server   *grpc.Server
-	listener net.Listener
-	config   *config.Server
+	id           string
+	identity     string
+	name         string
+	etcd         *etcd.Client
+	server       *grpc.Server
+	listener     net.Listener
+	loadRecorder orca.ServerMetricsRecorder
+	config       *config.Server
+	platform     *RPCPlatform
+
+	mu       sync.Mutex
+	cancel   context.CancelFunc
+	stopped  chan struct{}
+	graceful bool
 }
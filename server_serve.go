@@ -19,6 +19,7 @@ package rpcplatform
 import (
 	"context"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/nexcode/rpcplatform/internal/attributes"
@@ -27,15 +28,53 @@ import (
 )
 
 // Serve starts the gRPC server and blocks until it exits or an error occurs.
+// When ctx is done, the server removes itself from etcd and stops.
 func (s *Server) Serve(ctx context.Context) error {
 	path := s.name + "/" + s.id
+	name := strings.TrimPrefix(s.name, s.platform.etcdPrefix+"/")
+	metrics := s.platform.metrics
 	attributes := attributes.Values(s.config.Attributes)
 
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	stopped := make(chan struct{})
+
+	s.mu.Lock()
+	s.cancel, s.stopped = cancel, stopped
+	s.mu.Unlock()
+
+	defer func() {
+		s.platform.mu.Lock()
+		delete(s.platform.servers, s)
+		s.platform.mu.Unlock()
+	}()
+
 	go func() {
+		var leaseID etcd.LeaseID
+
+		defer close(stopped)
+
 		defer func() {
+			if leaseID != 0 {
+				ctxTimeout, cancelTimeout := gears.ContextTimeout(context.Background(), s.config.EtcdClientTimeout)
+				if _, err := s.etcd.Revoke(ctxTimeout, leaseID); err != nil {
+					log.Println(err)
+				}
+
+				cancelTimeout()
+			}
+
+			s.mu.Lock()
+			graceful := s.graceful
+			s.mu.Unlock()
+
+			// The stop method bounds a graceful stop by its own context.
+			if graceful {
+				s.Server().GracefulStop()
+				return
+			}
+
 			timer := time.AfterFunc(s.config.StopTimeout, func() {
 				s.Server().Stop()
 			})
@@ -57,17 +96,24 @@ func (s *Server) Serve(ctx context.Context) error {
 
 			if err != nil {
 				log.Println(err)
+				metrics.LeaseFailure(name, s.id)
 				continue
 			}
 
+			leaseID = lease.ID
+
 			addr := s.config.PublicAddr
 			if addr == "" {
 				addr = s.listener.Addr().String()
 			}
 
-			ops := make([]etcd.Op, 0, len(attributes)/2+1)
+			ops := make([]etcd.Op, 0, len(attributes)/2+2)
 			ops = append(ops, etcd.OpPut(path, addr, etcd.WithLease(lease.ID)))
 
+			if s.identity != "" {
+				ops = append(ops, etcd.OpPut(path+"/"+identityKey, s.identity, etcd.WithLease(lease.ID)))
+			}
+
 			for i := 0; i < len(attributes); i += 2 {
 				ops = append(ops, etcd.OpPut(path+"/"+attributes[i], attributes[i+1], etcd.WithLease(lease.ID)))
 			}
@@ -78,16 +124,19 @@ func (s *Server) Serve(ctx context.Context) error {
 
 			if err != nil {
 				log.Println(err)
+				metrics.LeaseFailure(name, s.id)
 				continue
 			}
 
 			if !resp.Succeeded {
+				metrics.LeaseFailure(name, s.id)
 				continue
 			}
 
 			keepAlive, err := s.etcd.KeepAlive(ctx, lease.ID)
 			if err != nil {
 				log.Println(err)
+				metrics.LeaseFailure(name, s.id)
 				continue
 			}
 
@@ -95,9 +144,19 @@ func (s *Server) Serve(ctx context.Context) error {
 				if _, ok := <-keepAlive; !ok {
 					break
 				}
+
+				metrics.LeaseRenewal(name, s.id)
+			}
+
+			if ctx.Err() == nil {
+				metrics.LeaseFailure(name, s.id)
 			}
 		}
 	}()
 
-	return s.server.Serve(s.listener)
+	err := s.server.Serve(s.listener)
+	cancel()
+	<-stopped
+
+	return err
 }
@@ -0,0 +1,47 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpcplatform
+
+import (
+	"context"
+)
+
+// stop removes the server from etcd and stops it gracefully regardless of the StopTimeout option.
+// A stop that does not finish before ctx is done is turned into an immediate one.
+func (s *Server) stop(ctx context.Context) error {
+	s.mu.Lock()
+	cancel, stopped := s.cancel, s.stopped
+	s.graceful = true
+	s.mu.Unlock()
+
+	if cancel == nil {
+		s.server.Stop()
+		return s.listener.Close()
+	}
+
+	cancel()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.server.Stop()
+		<-stopped
+
+		return ctx.Err()
+	}
+}
@@ -22,9 +22,13 @@ import (
 	"github.com/nexcode/rpcplatform/internal/attributes"
 )
 
+const identityKey = "identity"
+
 // ServerInfo contains information about a server stored in etcd.
+// Identity is set when the server platform has the ServiceIdentity option.
 type ServerInfo struct {
 	Address    string
+	Identity   string
 	Attributes *Attributes
 }
 
@@ -42,6 +46,8 @@ func makeServerInfo(m map[string]string) map[string]*ServerInfo {
 
 		if len(path) == 1 {
 			serverInfoTree[path[0]].Address = value
+		} else if path[1] == identityKey {
+			serverInfoTree[path[0]].Identity = value
 		} else {
 			attributes.Load(serverInfoTree[path[0]].Attributes, path[1], value)
 		}
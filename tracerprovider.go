@@ -0,0 +1,74 @@
+/*
+ * Copyright 2026 RPCPlatform Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpcplatform
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nexcode/rpcplatform/internal/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+)
+
+// newTracerProvider creates the tracer provider shared by all clients and servers of a platform.
+func newTracerProvider(config *config.Platform) (*trace.TracerProvider, error) {
+	resOptions := []resource.Option{
+		resource.WithHost(),
+		resource.WithOS(),
+		resource.WithContainer(),
+		resource.WithProcess(),
+		resource.WithTelemetrySDK(),
+		resource.WithSchemaURL(semconv.SchemaURL),
+		resource.WithAttributes(semconv.ServiceName(config.OpenTelemetry.ServiceName)),
+		resource.WithDetectors(config.Resource.Detectors...),
+		resource.WithAttributes(config.Resource.Attributes...),
+	}
+
+	if config.Resource.FromEnv {
+		resOptions = append(resOptions, resource.WithFromEnv())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.Resource.Timeout)
+	res, err := resource.New(ctx, resOptions...)
+	cancel()
+
+	// A partial resource is still usable, so the error is only reported.
+	if errors.Is(err, resource.ErrPartialResource) || errors.Is(err, resource.ErrSchemaURLConflict) {
+		otel.Handle(err)
+	} else if err != nil {
+		return nil, err
+	}
+
+	sampler := config.Sampler
+	if sampler == nil {
+		sampler = trace.ParentBased(trace.TraceIDRatioBased(config.OpenTelemetry.SampleRate))
+	}
+
+	tpOptions := []trace.TracerProviderOption{
+		trace.WithSampler(sampler),
+		trace.WithResource(res),
+	}
+
+	for _, exporter := range config.OpenTelemetry.Exporters {
+		tpOptions = append(tpOptions, trace.WithBatcher(exporter))
+	}
+
+	return trace.NewTracerProvider(tpOptions...), nil
+}